@@ -0,0 +1,90 @@
+package zerogdscript
+
+import "math"
+
+// Five-point Gauss-Legendre nodes and weights on [-1, 1].
+var (
+	gaussNodes   = [5]float64{-0.9061798459386640, -0.5384693101056831, 0, 0.5384693101056831, 0.9061798459386640}
+	gaussWeights = [5]float64{0.2369268850561891, 0.4786286704993665, 0.5688888888888889, 0.4786286704993665, 0.2369268850561891}
+)
+
+// gaussLegendre integrates f over [a, b] with five-point Gauss-Legendre quadrature.
+func gaussLegendre(f func(float64) float64, a, b float64) float64 {
+	half, mid := (b-a)/2, (a+b)/2
+	sum := 0.0
+	for i, x := range gaussNodes {
+		sum += gaussWeights[i] * f(mid+half*x)
+	}
+	return sum * half
+}
+
+// adaptiveGaussLegendre integrates f over [a, b], halving the interval until
+// the two halves agree with the whole to within tolerance.
+func adaptiveGaussLegendre(f func(float64) float64, a, b, whole, tolerance float64, depth int) float64 {
+	mid := (a + b) / 2
+	left, right := gaussLegendre(f, a, mid), gaussLegendre(f, mid, b)
+	if depth <= 0 || math.Abs(left+right-whole) <= tolerance {
+		return left + right
+	}
+	return adaptiveGaussLegendre(f, a, mid, left, tolerance/2, depth-1) +
+		adaptiveGaussLegendre(f, mid, b, right, tolerance/2, depth-1)
+}
+
+// ArcLength returns the length of a parametric curve between t0 and t1,
+// given its speed |dP/dt|, using five-point Gauss-Legendre quadrature on
+// each of subdivisions equal intervals. More subdivisions help where the
+// speed changes sharply, such as near a cusp. Values below 1 count as 1.
+func ArcLength(speed func(t float64) float64, t0, t1 float64, subdivisions int) float64 {
+	if subdivisions < 1 {
+		subdivisions = 1
+	}
+	step := (t1 - t0) / float64(subdivisions)
+	length := 0.0
+	for i := 0; i < subdivisions; i++ {
+		a := t0 + step*float64(i)
+		length += gaussLegendre(speed, a, a+step)
+	}
+	return length
+}
+
+// ArcLengthParameter returns the parameter t in [0, 1] at which the length of
+// a parametric curve, measured from t = 0, reaches distance. The curve is
+// given by its speed |dP/dt|. The arc length is integrated adaptively and t
+// is found by bisection until the length left to resolve is within
+// tolerance; a tolerance of 0 or less uses CMP_EPSILON. Distances of 0 or
+// less return 0 and distances past the end of the curve return 1.
+func ArcLengthParameter(speed func(t float64) float64, distance, tolerance float64) float64 {
+	if tolerance <= 0 {
+		tolerance = CMP_EPSILON
+	}
+	const maxDepth = 30
+	integrate := func(a, b, tol float64) float64 {
+		return adaptiveGaussLegendre(speed, a, b, gaussLegendre(speed, a, b), tol, maxDepth)
+	}
+
+	total := integrate(0, 1, tolerance)
+	if distance <= 0 {
+		return 0
+	}
+	if distance >= total {
+		return 1
+	}
+
+	// Keep [lo, hi] around the answer, with remaining the length still to
+	// cover from lo and seg the length of the whole interval.
+	lo, hi := 0.0, 1.0
+	remaining, seg := distance, total
+	for i := 0; i < 64 && seg > tolerance; i++ {
+		mid := (lo + hi) / 2
+		left := integrate(lo, mid, tolerance/4)
+		if left < remaining {
+			lo, remaining, seg = mid, remaining-left, seg-left
+		} else {
+			hi, seg = mid, left
+		}
+	}
+	if seg <= 0 {
+		return lo
+	}
+	return lo + (hi-lo)*Clampf(remaining/seg, 0, 1)
+}
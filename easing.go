@@ -0,0 +1,88 @@
+package zerogdscript
+
+import "math"
+
+// CubicBezierEasing is an easing curve defined like CSS cubic-bezier(x1, y1,
+// x2, y2): a cubic Bezier from (0, 0) to (1, 1) with control points (x1, y1)
+// and (x2, y2), read as y as a function of x. The polynomial coefficients are
+// computed once by NewCubicBezierEasing and never modified, so one curve can
+// be sampled from several goroutines at once.
+type CubicBezierEasing struct {
+	ax, bx, cx float64
+	ay, by, cy float64
+}
+
+// NewCubicBezierEasing returns the easing curve with control points (x1, y1)
+// and (x2, y2). As in CSS, x1 and x2 are clamped to [0, 1] so that x always
+// increases along the curve; y1 and y2 may be outside it to overshoot.
+func NewCubicBezierEasing(x1, y1, x2, y2 float64) CubicBezierEasing {
+	x1 = Clampf(x1, 0, 1)
+	x2 = Clampf(x2, 0, 1)
+	var e CubicBezierEasing
+	e.cx = 3 * x1
+	e.bx = 3*(x2-x1) - e.cx
+	e.ax = 1 - e.cx - e.bx
+	e.cy = 3 * y1
+	e.by = 3*(y2-y1) - e.cy
+	e.ay = 1 - e.cy - e.by
+	return e
+}
+
+// Sample returns the eased value at progress x. x is clamped to [0, 1], so
+// Sample(0) is 0 and Sample(1) is 1.
+func (e CubicBezierEasing) Sample(x float64) float64 {
+	if x <= 0 {
+		return 0
+	}
+	if x >= 1 {
+		return 1
+	}
+	t := e.solveT(x)
+	return ((e.ay*t+e.by)*t + e.cy) * t
+}
+
+func (e CubicBezierEasing) sampleX(t float64) float64 {
+	return ((e.ax*t+e.bx)*t + e.cx) * t
+}
+
+func (e CubicBezierEasing) sampleDerivativeX(t float64) float64 {
+	return (3*e.ax*t+2*e.bx)*t + e.cx
+}
+
+// solveT returns the curve parameter t at which the curve reaches x. Newton's
+// method converges in a few steps on most curves; where the slope in x is
+// too flat for it or it leaves [0, 1], bisection finishes the job.
+func (e CubicBezierEasing) solveT(x float64) float64 {
+	const tolerance = 1e-9
+	t := x
+	for i := 0; i < 8; i++ {
+		dx := e.sampleX(t) - x
+		if math.Abs(dx) < tolerance {
+			return t
+		}
+		d := e.sampleDerivativeX(t)
+		if math.Abs(d) < 1e-6 {
+			break
+		}
+		t -= dx / d
+		if t < 0 || t > 1 {
+			break
+		}
+	}
+
+	lo, hi := 0.0, 1.0
+	t = x
+	for i := 0; i < 64; i++ {
+		dx := e.sampleX(t) - x
+		if math.Abs(dx) < tolerance {
+			break
+		}
+		if dx > 0 {
+			hi = t
+		} else {
+			lo = t
+		}
+		t = (lo + hi) / 2
+	}
+	return t
+}
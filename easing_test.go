@@ -0,0 +1,71 @@
+package zerogdscript
+
+import (
+	"math"
+	"sync"
+	"testing"
+)
+
+func TestMathgd_CubicBezierEasing(t *testing.T) {
+	xs := []float64{0.1, 0.25, 0.5, 0.75, 0.9}
+	for _, tt := range []struct {
+		name           string
+		x1, y1, x2, y2 float64
+		want           []float64
+	}{
+		{"ease", 0.25, 0.1, 0.25, 1, []float64{0.094796306, 0.408510591, 0.802403388, 0.960458978, 0.994316477}},
+		{"ease-in", 0.42, 0, 1, 1, []float64{0.01702661, 0.093464651, 0.315356813, 0.621861869, 0.839427846}},
+		{"ease-out", 0, 0, 0.58, 1, []float64{0.160572154, 0.378138131, 0.684643187, 0.906535349, 0.98297339}},
+		{"ease-in-out", 0.42, 0, 0.58, 1, []float64{0.019722454, 0.129161931, 0.5, 0.870838069, 0.980277546}},
+		{"back", 0.68, -0.55, 0.265, 1.55, []float64{-0.066291477, -0.082807109, 0.606679897, 1.089165775, 1.062373195}},
+	} {
+		e := NewCubicBezierEasing(tt.x1, tt.y1, tt.x2, tt.y2)
+		for i, x := range xs {
+			if got := e.Sample(x); math.Abs(got-tt.want[i]) > 1e-5 {
+				t.Errorf("%s: Sample(%v) = %v, want %v", tt.name, x, got, tt.want[i])
+			}
+		}
+		if got := e.Sample(0); got != 0 {
+			t.Errorf("%s: Sample(0) = %v, want 0", tt.name, got)
+		}
+		if got := e.Sample(1); got != 1 {
+			t.Errorf("%s: Sample(1) = %v, want 1", tt.name, got)
+		}
+	}
+
+	// The linear curve is the identity.
+	linear := NewCubicBezierEasing(0, 0, 1, 1)
+	for x := 0.0; x <= 1; x += 0.05 {
+		if got := linear.Sample(x); math.Abs(got-x) > 1e-9 {
+			t.Errorf("linear: Sample(%v) = %v", x, got)
+		}
+	}
+
+	// Vertical tangents at both ends leave Newton's method with no slope to
+	// work with, so these go through the bisection fallback.
+	steep := NewCubicBezierEasing(1, 0, 0, 1)
+	for x := 0.0; x <= 1; x += 0.01 {
+		if y := steep.Sample(x); y < 0 || y > 1 || math.Abs(steep.sampleX(steep.solveT(x))-x) > 1e-7 {
+			t.Fatalf("steep: Sample(%v) = %v off the curve", x, y)
+		}
+	}
+}
+
+func TestMathgd_CubicBezierEasingConcurrent(t *testing.T) {
+	e := NewCubicBezierEasing(0.25, 0.1, 0.25, 1)
+	want := e.Sample(0.3)
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 1000; j++ {
+				if got := e.Sample(0.3); got != want {
+					t.Errorf("concurrent Sample(0.3) = %v, want %v", got, want)
+					return
+				}
+			}
+		}()
+	}
+	wg.Wait()
+}
@@ -0,0 +1,38 @@
+package zerogdscript
+
+import "errors"
+
+// Error policy
+//
+// Functions in this module never panic on bad data. Arithmetic follows
+// IEEE-754 like Godot does, so dividing by zero yields ±Inf or NaN.
+// Inputs that are only slightly off, such as a normal that is not quite unit
+// length, are fixed up silently. Inputs that cannot be fixed, such as a
+// zero-length normal or a singular matrix, are reported by the ...Checked
+// variants and the in-place Invert methods through the errors below, while
+// the plain variants return a documented fallback.
+
+var (
+	// ErrZeroLength is returned when a normal, axis or direction has zero length.
+	ErrZeroLength = errors.New("vector has zero length")
+
+	// ErrSingular is returned when a matrix or transform has a zero determinant and cannot be inverted.
+	ErrSingular = errors.New("matrix is not invertible, determinant is zero")
+
+	// ErrInvalidGeometry is returned when a polygon operation gets non-finite or
+	// out-of-range input, or cannot produce a simple, valid result.
+	ErrInvalidGeometry = errors.New("invalid polygon geometry")
+
+	// ErrNonFinite is reported by Validate for NaN or infinite components.
+	ErrNonFinite = errors.New("value is not finite")
+
+	// ErrNotNormalized is reported by Validate for a rotation quaternion that is not unit length.
+	ErrNotNormalized = errors.New("quaternion is not normalized")
+
+	// ErrInvalidWeights is returned for weights that are empty, negative,
+	// non-finite or all zero.
+	ErrInvalidWeights = errors.New("weights must be finite, non-negative and not all zero")
+
+	// ErrInvalidLength is returned when a flat buffer has the wrong number of elements.
+	ErrInvalidLength = errors.New("buffer has the wrong length")
+)
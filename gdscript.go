@@ -1,6 +1,12 @@
 package zerogdscript
 
-import "math"
+import (
+	"math"
+	"math/bits"
+	"strconv"
+
+	"github.com/Anaxarchus/zero-gdscript/internal/core"
+)
 
 /**************************************************************************/
 /*  math_funcs.h, math_defs.h                                             */
@@ -36,23 +42,29 @@ import "math"
 /* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
 /**************************************************************************/
 
-// CMP_EPSILON represents the tolerance value used for floating-point comparison.
-const CMP_EPSILON = 0.00001
+// The tolerance constants are shared with mathgd32 through internal/core.
+const (
+	// CMP_EPSILON represents the tolerance value used for floating-point comparison.
+	CMP_EPSILON = core.CMP_EPSILON
+
+	// CMP_EPSILON2 represents the square of CMP_EPSILON.
+	CMP_EPSILON2 = core.CMP_EPSILON2
 
-// CMP_EPSILON2 represents the square of CMP_EPSILON.
-const CMP_EPSILON2 = CMP_EPSILON * CMP_EPSILON
+	// CMP_NORMALIZE_TOLERANCE represents the tolerance value used for normalizing vectors.
+	CMP_NORMALIZE_TOLERANCE = core.CMP_NORMALIZE_TOLERANCE
 
-// CMP_NORMALIZE_TOLERANCE represents the tolerance value used for normalizing vectors.
-const CMP_NORMALIZE_TOLERANCE = 0.000001
+	// CMP_POINT_IN_PLANE_EPSILON represents the tolerance value used for checking if a point lies on a plane.
+	CMP_POINT_IN_PLANE_EPSILON = core.CMP_POINT_IN_PLANE_EPSILON
 
-// CMP_POINT_IN_PLANE_EPSILON represents the tolerance value used for checking if a point lies on a plane.
-const CMP_POINT_IN_PLANE_EPSILON = 0.00001
+	// UNIT_EPSILON represents the tolerance value used for checking unit length.
+	UNIT_EPSILON = core.UNIT_EPSILON
 
-// TAU represents the mathematical constant Tau (2 * Pi).
-const TAU = 6.2831853071795864769252867666
+	// TAU represents the mathematical constant Tau (2 * Pi).
+	TAU = core.TAU
 
-// PI represents the mathematical constant Pi.
-const PI = 3.1415926535897932384626433833
+	// PI represents the mathematical constant Pi.
+	PI = core.PI
+)
 
 type EulerOrder int
 
@@ -65,25 +77,39 @@ const (
 	EulerOrderZYX
 )
 
+// IsFinitef reports whether x is neither NaN nor an infinity.
+func IsFinitef(x float64) bool {
+	return core.IsFinitef(x)
+}
+
 // IsZeroApprox checks if a floating-point number is approximately zero within a certain tolerance.
+// NaN and infinities are never approximately zero.
 func IsZeroApprox(x float64) bool {
-	return math.Abs(x) < CMP_EPSILON
+	return core.IsZeroApprox(x)
 }
 
 // IsEqualApprox checks if two floating-point numbers are approximately equal within a certain tolerance.
+// An infinity equals only the infinity of the same sign and NaN equals nothing, itself included.
 func IsEqualApprox(x, y float64) bool {
-	return IsZeroApprox(x - y)
+	return core.IsEqualApprox(x, y)
+}
+
+// IsEqualApproxTol checks if x and y differ by less than the given absolute tolerance.
+func IsEqualApproxTol(x, y, tolerance float64) bool {
+	return core.IsEqualApproxTol(x, y, tolerance)
+}
+
+// IsEqualApproxRelative checks if x and y are approximately equal with a tolerance
+// of CMP_EPSILON scaled by abs(x), as Godot's is_equal_approx does. Use it to compare
+// large values, such as coordinates in the millions.
+func IsEqualApproxRelative(x, y float64) bool {
+	return core.IsEqualApproxRelative(x, y)
 }
 
 // Sign returns the sign of a floating-point number.
 // It returns 1 if x is positive, -1 if x is negative, and 0 if x is zero.
 func Sign(x float64) float64 {
-	if x > 0 {
-		return 1
-	} else if x < 0 {
-		return -1
-	}
-	return 0
+	return core.Sign(x)
 }
 
 // Clamp clamps a value within a specified range.
@@ -100,37 +126,128 @@ func Clampi(val, min, max int) int {
 	}
 }
 
+// Absi returns the absolute value of an integer. Like Godot, Absi(math.MinInt)
+// overflows and returns math.MinInt.
+func Absi(x int) int {
+	if x < 0 {
+		return -x
+	}
+	return x
+}
+
+// Signi returns the sign of an integer: 1, -1 or 0.
+func Signi(x int) int {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	}
+	return 0
+}
+
+// Posmodi returns the integer modulus of x modulo y with the sign of y, so
+// Posmodi(-1, 3) is 2 and Posmodi(1, -3) is -2. It returns 0 when y is 0.
+func Posmodi(x, y int) int {
+	if y == 0 {
+		return 0
+	}
+	value := x % y
+	if (value < 0 && y > 0) || (value > 0 && y < 0) {
+		value += y
+	}
+	return value
+}
+
+// NearestPo2 returns the smallest power of two greater than or equal to v.
+// It returns 0 for v <= 0, and also for v above the largest power of two an
+// int can hold (1<<62 on 64-bit platforms, 1<<30 on 32-bit ones), whose next
+// power of two does not fit.
+func NearestPo2(v int) int {
+	if v <= 0 || v > 1<<(bits.UintSize-2) {
+		return 0
+	}
+	return 1 << bits.Len(uint(v-1))
+}
+
 // Clamp clamps a value within a specified range.
 // If val is less than min, it returns min.
 // If val is greater than max, it returns max.
 // Otherwise, it returns val.
 func Clampf(val, min, max float64) float64 {
-	if val < min {
-		return min
-	} else if val > max {
-		return max
-	} else {
-		return val
-	}
+	return core.Clampf(val, min, max)
 }
 
-// Snapped returns the nearest value to 'from' that is a multiple of 'to'.
-// If 'to' is zero, it returns 0.
+// Snapped returns the nearest value to 'from' that is a multiple of 'to',
+// rounding halfway values away from zero. A negative 'to' is treated as its
+// absolute value. If 'to' is zero, 'from' is returned unchanged, as in Godot
+// and Snappedi.
 func Snapped(from, to float64) float64 {
-	if to == 0 {
-		return 0
+	return core.Snapped(from, to)
+}
+
+// Snappedi is the integer version of Snapped: it returns the multiple of 'step'
+// nearest to 'value', rounding halfway values away from zero, computed without
+// going through floating point. A negative 'step' is treated as its absolute
+// value, and a zero 'step' returns 'value' unchanged, as Snapped does.
+func Snappedi(value, step int) int {
+	if step < 0 {
+		step = -step
+	}
+	if step == 0 {
+		return value
+	}
+	q, r := value/step, value%step
+	if r < 0 {
+		r = -r
+	}
+	// r >= step - r rather than 2*r >= step, which could overflow.
+	if r >= step-r {
+		if value < 0 {
+			q--
+		} else {
+			q++
+		}
+	}
+	return q * step
+}
+
+// stepDecimalThresholds[i] is the smallest fractional part that needs i decimals.
+var stepDecimalThresholds = [...]float64{0.9999, 0.09999, 0.009999, 0.0009999, 0.00009999, 0.000009999, 0.0000009999, 0.00000009999, 0.000000009999, 0.0000000009999}
+
+// StepDecimals returns the number of decimal digits needed to write step, for
+// example 2 for 0.01 and 0 for 5. Like Godot's step_decimals it looks at the
+// leading digit of the fractional part only and gives up after 9 decimals,
+// returning 0.
+func StepDecimals(step float64) int {
+	abs := math.Abs(step)
+	decs := abs - math.Trunc(abs)
+	for i, threshold := range stepDecimalThresholds {
+		if decs >= threshold {
+			return i
+		}
+	}
+	return 0
+}
+
+// SnappedDecimals rounds value to the given number of decimal places and
+// returns the float64 closest to that decimal. The result prints cleanly, so
+// SnappedDecimals(Snapped(0.3, 0.1), StepDecimals(0.1)) is 0.3 rather than
+// 0.30000000000000004. Negative decimals are treated as 0.
+func SnappedDecimals(value float64, decimals int) float64 {
+	if decimals < 0 {
+		decimals = 0
+	}
+	res, err := strconv.ParseFloat(strconv.FormatFloat(value, 'f', decimals, 64), 64)
+	if err != nil {
+		return value
 	}
-	return math.Round(from/to) * to
+	return res
 }
 
 // Fposmod returns the positive floating-point modulus of x modulo y.
 // If the result of the modulo operation is negative, it wraps around to ensure a positive result.
 func Fposmod(x, y float64) float64 {
-	result := math.Mod(x, y)
-	if result < 0 {
-		result += y
-	}
-	return result
+	return core.Fposmod(x, y)
 }
 
 // DegToRad converts degrees to radians.
@@ -148,7 +265,13 @@ func RadToDeg(rad float64) float64 {
 // Lerp performs linear interpolation between two values.
 // It returns a value between 'p_from' and 'p_to' based on the interpolation weight 'p_weight'.
 func Lerp(p_from, p_to, p_weight float64) float64 {
-	return p_from + (p_to-p_from)*p_weight
+	return core.Lerp(p_from, p_to, p_weight)
+}
+
+// LerpClamped is like Lerp but clamps 'p_weight' to [0, 1] first, so the result
+// never overshoots 'p_from' or 'p_to' however far out of range the weight is.
+func LerpClamped(p_from, p_to, p_weight float64) float64 {
+	return Lerp(p_from, p_to, Clampf(p_weight, 0, 1))
 }
 
 // CubicInterpolate performs cubic interpolation between two values.
@@ -162,6 +285,49 @@ func CubicInterpolate(p_from, p_to, p_pre, p_post, p_weight float64) float64 {
 			(-p_pre+3.0*p_from-3.0*p_to+p_post)*(p_weight*p_weight*p_weight))
 }
 
+// CatmullRom evaluates the cardinal spline segment from p1 to p2 at t in [0, 1],
+// with p0 and p3 as the neighbouring control points. The tangent at p1 is
+// tension*(p2-p0) and the tangent at p2 is tension*(p3-p1). Tension 0.5 is the
+// uniform Catmull-Rom spline and gives the same curve as
+// CubicInterpolate(p1, p2, p0, p3, t); lower values tighten the curve.
+func CatmullRom(p0, p1, p2, p3, t, tension float64) float64 {
+	t2 := t * t
+	t3 := t2 * t
+	return p1 +
+		tension*(p2-p0)*t +
+		(2.0*tension*p0+(tension-3.0)*p1+(3.0-2.0*tension)*p2-tension*p3)*t2 +
+		(-tension*p0+(2.0-tension)*p1+(tension-2.0)*p2+tension*p3)*t3
+}
+
+// CatmullRomCentripetal evaluates the centripetal Catmull-Rom segment from p1 to
+// p2 at t in [0, 1]. The knots are spaced by the square root of the distance
+// between consecutive points, which avoids the overshoot, cusps and loops the
+// uniform spline produces when the points are unevenly spaced.
+func CatmullRomCentripetal(p0, p1, p2, p3, t float64) float64 {
+	pre, to, post := CentripetalKnots(math.Abs(p1-p0), math.Abs(p2-p1), math.Abs(p3-p2))
+	return CubicInterpolateInTime(p1, p2, p0, p3, t, to, pre, post)
+}
+
+// CentripetalKnots returns the knot times of p0, p2 and p3 for a centripetal
+// Catmull-Rom segment, with p1 at time 0, given the distances between
+// consecutive control points. The results are the p_pre_t, p_to_t and
+// p_post_t arguments of CubicInterpolateInTime. A zero-length middle interval
+// is treated as 1 and a zero-length outer interval takes the middle one, so
+// repeated control points still give a finite curve.
+func CentripetalKnots(d01, d12, d23 float64) (preT, toT, postT float64) {
+	dt0, dt1, dt2 := math.Sqrt(d01), math.Sqrt(d12), math.Sqrt(d23)
+	if dt1 < CMP_EPSILON {
+		dt1 = 1.0
+	}
+	if dt0 < CMP_EPSILON {
+		dt0 = dt1
+	}
+	if dt2 < CMP_EPSILON {
+		dt2 = dt1
+	}
+	return -dt0, dt1, dt1 + dt2
+}
+
 // CubicInterpolateAngle performs cubic interpolation between two angles represented in radians.
 // It ensures smooth interpolation by handling angle wrapping around the unit circle.
 func CubicInterpolateAngle(p_from, p_to, p_pre, p_post, p_weight float64) float64 {
@@ -264,11 +430,96 @@ func BezierDerivative(p_start, p_control_1, p_control_2, p_end, p_t float64) flo
 	return d
 }
 
+// BezierLength returns the arc length of a cubic Bezier curve, the total
+// distance a point moving along it covers. See ArcLength for subdivisions.
+func BezierLength(p_start, p_control_1, p_control_2, p_end float64, subdivisions int) float64 {
+	return ArcLength(func(t float64) float64 {
+		return math.Abs(BezierDerivative(p_start, p_control_1, p_control_2, p_end, t))
+	}, 0, 1, subdivisions)
+}
+
+// BezierSampleByDistance returns the position t along a cubic Bezier curve at
+// which the arc length from the start reaches 'distance', to within
+// CMP_EPSILON. Stepping the distance evenly moves along the curve at constant
+// speed. See ArcLengthParameter for the handling of out-of-range distances.
+func BezierSampleByDistance(p_start, p_control_1, p_control_2, p_end, distance float64) float64 {
+	return ArcLengthParameter(func(t float64) float64 {
+		return math.Abs(BezierDerivative(p_start, p_control_1, p_control_2, p_end, t))
+	}, distance, CMP_EPSILON)
+}
+
+// BezierSecondDerivative calculates the second derivative of a cubic Bezier curve at position 'p_t'.
+func BezierSecondDerivative(p_start, p_control_1, p_control_2, p_end, p_t float64) float64 {
+	return (p_control_2-2.0*p_control_1+p_start)*6.0*(1.0-p_t) + (p_end-2.0*p_control_2+p_control_1)*6.0*p_t
+}
+
+// BezierCurvature calculates the signed curvature of the graph of a cubic Bezier
+// curve, the plane curve (t, B(t)), at position 'p_t'. It is positive where the
+// curve bends upwards. Use Vector2.BezierCurvature for a curve in the plane.
+func BezierCurvature(p_start, p_control_1, p_control_2, p_end, p_t float64) float64 {
+	d1 := BezierDerivative(p_start, p_control_1, p_control_2, p_end, p_t)
+	d2 := BezierSecondDerivative(p_start, p_control_1, p_control_2, p_end, p_t)
+	return d2 / math.Pow(1.0+d1*d1, 1.5)
+}
+
 // AngleDifference calculates the difference between two angles in radians.
 // It returns the difference between 'p_from' and 'p_to' taking into account angle wrapping around the unit circle.
 func AngleDifference(p_from, p_to float64) float64 {
-	difference := math.Mod(p_to-p_from, TAU)
-	return math.Mod(2.0*difference, TAU) - difference
+	return angleDifference(p_from, p_to, TAU)
+}
+
+// AngleDifferenceDeg is AngleDifference for degrees. It wraps at 360 directly
+// rather than converting to radians, so large angles keep their precision.
+func AngleDifferenceDeg(p_from, p_to float64) float64 {
+	return angleDifference(p_from, p_to, 360.0)
+}
+
+func angleDifference(p_from, p_to, period float64) float64 {
+	difference := math.Mod(p_to-p_from, period)
+	return math.Mod(2.0*difference, period) - difference
+}
+
+// WrapAngle wraps an angle in radians into [-PI, PI). It reduces with
+// math.Remainder instead of repeated subtraction, so very large angles stay as
+// accurate as TAU itself allows.
+func WrapAngle(a float64) float64 {
+	return wrapSymmetric(a, TAU)
+}
+
+// NormalizeAngle wraps an angle in radians into [0, TAU), reducing exactly
+// like WrapAngle.
+func NormalizeAngle(a float64) float64 {
+	return wrapPositive(a, TAU)
+}
+
+// WrapAngleDeg is WrapAngle for degrees, wrapping into [-180, 180).
+func WrapAngleDeg(a float64) float64 {
+	return wrapSymmetric(a, 360.0)
+}
+
+// NormalizeAngleDeg is NormalizeAngle for degrees, wrapping into [0, 360).
+func NormalizeAngleDeg(a float64) float64 {
+	return wrapPositive(a, 360.0)
+}
+
+func wrapSymmetric(a, period float64) float64 {
+	r := math.Remainder(a, period)
+	if r >= period/2 {
+		r -= period
+	}
+	return r
+}
+
+func wrapPositive(a, period float64) float64 {
+	r := math.Mod(a, period)
+	if r < 0 {
+		r += period
+		// A tiny negative remainder can round up to period itself.
+		if r >= period {
+			r = 0
+		}
+	}
+	return r
 }
 
 // LerpAngle performs linear interpolation between two angles represented in radians.
@@ -277,19 +528,36 @@ func LerpAngle(p_from, p_to, p_weight float64) float64 {
 	return p_from + AngleDifference(p_from, p_to)*p_weight
 }
 
+// LerpAngleDeg is LerpAngle for degrees, taking the short way around at 360.
+func LerpAngleDeg(p_from, p_to, p_weight float64) float64 {
+	return p_from + AngleDifferenceDeg(p_from, p_to)*p_weight
+}
+
 // InverseLerp calculates the interpolation parameter ('t') between two values 'p_from' and 'p_to' based on a given value 'p_value'.
 // It returns the interpolation parameter that corresponds to 'p_value' relative to the range between 'p_from' and 'p_to'.
+// An empty range, 'p_from' equal to 'p_to', returns 0 instead of dividing by zero.
 func InverseLerp(p_from, p_to, p_value float64) float64 {
+	if p_from == p_to {
+		return 0
+	}
 	return (p_value - p_from) / (p_to - p_from)
 }
 
 // Remap remaps a value from one range to another.
 // It linearly interpolates the value 'p_value' from the range defined by 'p_istart' and 'p_istop'
-// to the range defined by 'p_ostart' and 'p_ostop'.
+// to the range defined by 'p_ostart' and 'p_ostop'. Values outside the input range are
+// extrapolated, and either range may be reversed. An empty input range, 'p_istart' equal
+// to 'p_istop', returns 'p_ostart' instead of dividing by zero.
 func Remap(p_value, p_istart, p_istop, p_ostart, p_ostop float64) float64 {
 	return Lerp(p_ostart, p_ostop, InverseLerp(p_istart, p_istop, p_value))
 }
 
+// RemapClamped is like Remap but clamps the interpolation factor to [0, 1], so the
+// result never leaves the output range. An empty input range returns 'p_ostart'.
+func RemapClamped(p_value, p_istart, p_istop, p_ostart, p_ostop float64) float64 {
+	return Lerp(p_ostart, p_ostop, Clampf(InverseLerp(p_istart, p_istop, p_value), 0, 1))
+}
+
 // Smoothstep interpolates smoothly between two values based on a third value.
 // It returns a value between 'p_from' and 'p_to' based on 'p_s', using Hermite interpolation.
 func Smoothstep(p_from, p_to, p_s float64) float64 {
@@ -300,6 +568,58 @@ func Smoothstep(p_from, p_to, p_s float64) float64 {
 	return s * s * (3.0 - 2.0*s)
 }
 
+// Step returns 0 if x is less than edge and 1 otherwise, like GLSL's step.
+func Step(edge, x float64) float64 {
+	if x < edge {
+		return 0
+	}
+	return 1
+}
+
+// SmoothstepDerivative returns the slope of Smoothstep(p_from, p_to, p_s) with
+// respect to p_s. It is 0 outside the range and, as Smoothstep has no slope
+// to report there, when p_from and p_to are approximately equal.
+func SmoothstepDerivative(p_from, p_to, p_s float64) float64 {
+	if IsEqualApprox(p_from, p_to) {
+		return 0
+	}
+	s := (p_s - p_from) / (p_to - p_from)
+	if s <= 0 || s >= 1 {
+		return 0
+	}
+	return 6.0 * s * (1.0 - s) / (p_to - p_from)
+}
+
+// Smootherstep is like Smoothstep but uses Perlin's quintic 6s^5 - 15s^4 + 10s^3,
+// whose first and second derivatives are both zero at the ends of the range.
+// It clamps and handles p_from == p_to the same way Smoothstep does.
+func Smootherstep(p_from, p_to, p_s float64) float64 {
+	if IsEqualApprox(p_from, p_to) {
+		return p_from
+	}
+	s := Clampf((p_s-p_from)/(p_to-p_from), 0.0, 1.0)
+	return s * s * s * (s*(s*6.0-15.0) + 10.0)
+}
+
+// SmoothMin returns the minimum of a and b with the crease rounded off, using
+// the quadratic polynomial smooth minimum common in signed distance field
+// modelling. Where a and b differ by k or more it is exactly min(a, b); closer
+// together it dips below both, by at most k/4 where they are equal. A k of 0
+// or less gives math.Min.
+func SmoothMin(a, b, k float64) float64 {
+	if k <= 0 {
+		return math.Min(a, b)
+	}
+	h := math.Max(k-math.Abs(a-b), 0) / k
+	return math.Min(a, b) - h*h*k*0.25
+}
+
+// SmoothMax is the smooth maximum counterpart of SmoothMin, rising above both
+// values by at most k/4.
+func SmoothMax(a, b, k float64) float64 {
+	return -SmoothMin(-a, -b, k)
+}
+
 // MoveToward moves a value towards another value by a given delta amount.
 // It returns the value moved from 'p_from' towards 'p_to' by 'p_delta' amount.
 func MoveToward(p_from, p_to, p_delta float64) float64 {
@@ -309,17 +629,77 @@ func MoveToward(p_from, p_to, p_delta float64) float64 {
 	return p_from + Sign(p_to-p_from)*p_delta
 }
 
+// Spring advances a damped spring pulling current toward target by dt seconds
+// and returns the new value and velocity. stiffness is the spring constant
+// per unit mass and damping the velocity drag; damping below
+// 2*sqrt(stiffness) overshoots and oscillates, above it settles without
+// overshoot. The velocity is updated before the position (semi-implicit
+// Euler), which stays stable for stiffness*dt*dt well below 1.
+func Spring(current, target, velocity, stiffness, damping, dt float64) (newValue, newVelocity float64) {
+	accel := -stiffness*(current-target) - damping*velocity
+	newVelocity = velocity + accel*dt
+	newValue = current + newVelocity*dt
+	return newValue, newVelocity
+}
+
+// SmoothDamp moves current toward target like a critically damped spring and
+// returns the new value. smoothTime is roughly the time it takes to get there;
+// below 0.0001 there is no smoothing and current moves straight to target.
+// velocity carries the rate of change between calls and is updated in place;
+// start it at 0. The speed is capped at maxSpeed, so pass math.Inf(1) for no
+// cap. The result never passes target, and once it is within CMP_EPSILON it
+// lands exactly on target with the velocity zeroed. A delta of 0 or less
+// returns current unchanged.
+func SmoothDamp(current, target float64, velocity *float64, smoothTime, maxSpeed, delta float64) float64 {
+	if delta <= 0 {
+		return current
+	}
+	if smoothTime < 0.0001 {
+		output := MoveToward(current, target, maxSpeed*delta)
+		*velocity = (output - current) / delta
+		if output == target {
+			*velocity = 0
+		}
+		return output
+	}
+	omega := 2.0 / smoothTime
+	x := omega * delta
+	// Pade approximation of exp(-x).
+	decay := 1.0 / (1.0 + x + 0.48*x*x + 0.235*x*x*x)
+	maxChange := maxSpeed * smoothTime
+	change := Clampf(current-target, -maxChange, maxChange)
+	temp := (*velocity + omega*change) * delta
+	*velocity = (*velocity - omega*temp) * decay
+	output := current - change + (change+temp)*decay
+	if (target-current > 0) == (output > target) || math.Abs(output-target) <= CMP_EPSILON {
+		*velocity = 0
+		return target
+	}
+	return output
+}
+
 // RotateToward rotates a value towards another value by a given delta amount.
-// It returns the value rotated from 'p_from' towards 'p_to' by 'p_delta' amount.
+// It returns the value rotated from 'p_from' towards 'p_to' by 'p_delta' amount,
+// going the short way around and never past 'p_to'. A negative 'p_delta' rotates
+// away from 'p_to', but no further than to the angle opposite it.
 func RotateToward(p_from, p_to, p_delta float64) float64 {
-	difference := AngleDifference(p_from, p_to)
+	return rotateToward(p_from, p_to, p_delta, TAU)
+}
+
+// RotateTowardDeg is RotateToward for degrees, with 'p_delta' in degrees too.
+func RotateTowardDeg(p_from, p_to, p_delta float64) float64 {
+	return rotateToward(p_from, p_to, p_delta, 360.0)
+}
+
+func rotateToward(p_from, p_to, p_delta, period float64) float64 {
+	difference := angleDifference(p_from, p_to, period)
 	abs_difference := math.Abs(difference)
-	// When `p_delta < 0` move no further than to PI radians away from `p_to` (as PI is the max possible angle distance).
-	r := p_from + Clampf(p_delta, abs_difference-PI, abs_difference)
-	if difference >= 0.0 {
-		return r * 1.0
+	// When `p_delta < 0` move no further than to half a turn away from `p_to` (as that is the max possible angle distance).
+	offset := Clampf(p_delta, abs_difference-period/2, abs_difference)
+	if difference < 0.0 {
+		offset = -offset
 	}
-	return -1.0
+	return p_from + offset
 }
 
 // LinearToDb converts a linear value to decibels.
@@ -328,9 +708,24 @@ func LinearToDb(p_linear float64) float64 {
 	return math.Log(p_linear) * 8.6858896380650365530225783783321
 }
 
+// LinearToDbClamped is like LinearToDb but never goes below minDb, the
+// silence threshold (-80 is a common choice). Zero, negative and NaN input
+// count as silence and return minDb, so the result is always safe to feed
+// back into bus math.
+func LinearToDbClamped(p_linear, minDb float64) float64 {
+	if !(p_linear > 0) {
+		return minDb
+	}
+	return math.Max(LinearToDb(p_linear), minDb)
+}
+
 // DbToLinear converts a decibel value to linear scale.
 // It returns the exponential conversion of 'p_db' value from decibels to linear scale.
+// -Inf decibels, the result of LinearToDb(0), gives exactly 0.
 func DbToLinear(p_db float64) float64 {
+	if math.IsInf(p_db, -1) {
+		return 0
+	}
 	return math.Exp(p_db * 0.11512925464970228420089957273422)
 }
 
@@ -365,25 +760,41 @@ func Fract(value float64) float64 {
 }
 
 // Pingpong calculates the ping-pong value within a specified length.
-// It returns the ping-pong value of 'value' within the range defined by 'length'.
+// It returns the ping-pong value of 'value' within the range defined by 'length':
+// a triangle wave in [0, |length|] that is 0 at even multiples of 'length' and
+// |length| at odd ones. A negative length behaves like its absolute value, as in
+// Godot. A zero length, or a NaN or infinite 'value' or 'length', returns 0.
 func Pingpong(value, length float64) float64 {
-	if length == 0.0 {
-		return length
+	length = math.Abs(length)
+	if length == 0.0 || !IsFinitef(value) || !IsFinitef(length) {
+		return 0.0
 	}
-	return math.Abs(Fract((value-length)/(length*2.0))*length*2.0 - length)
+	// math.Mod is exact, unlike the division in Godot's fract form, so exact
+	// multiples of the period land on 0 and huge values keep their phase.
+	period := length * 2.0
+	t := math.Mod(value, period)
+	if t < 0 {
+		t += period
+	}
+	if t > length {
+		t = period - t
+	}
+	return t
 }
 
 // SnapScalar snaps a value to the nearest multiple of a step size.
 // It returns the snapped value of 'p_target' relative to 'p_offset' and 'p_step'.
+// A zero 'p_step' returns 'p_target' unchanged.
 func SnapScalar(p_offset, p_step, p_target float64) float64 {
-	if p_step == 0 {
+	if p_step != 0 {
 		return Snapped(p_target-p_offset, p_step) + p_offset
 	}
 	return p_target
 }
 
-// SnapScalarSeparation snaps a value to the nearest multiple of a step size with a specified separation.
-// It returns the snapped value of 'p_target' relative to 'p_offset', 'p_step', and 'p_separation'.
+// SnapScalarSeparation snaps 'p_target' to the nearest edge of a row of cells of
+// size 'p_step' separated by gaps of 'p_separation', starting at 'p_offset'.
+// A zero 'p_step' returns 'p_target' unchanged.
 func SnapScalarSeparation(p_offset, p_step, p_target, p_separation float64) float64 {
 	if p_step != 0 {
 		a := Snapped(p_target-p_offset, p_step+p_separation) + p_offset
@@ -401,3 +812,44 @@ func SnapScalarSeparation(p_offset, p_step, p_target, p_separation float64) floa
 	}
 	return p_target
 }
+
+// NormalizeWeights returns a new slice where negative weights are clamped to zero
+// and the remaining weights are scaled so that they sum to 1.
+// If every weight is zero (or negative), an equal distribution is returned.
+// NaN weights count as zero. If any weight is +Inf, the +Inf weights dominate
+// and share the total equally while every finite weight becomes zero.
+func NormalizeWeights(weights []float64) []float64 {
+	res := make([]float64, len(weights))
+	infs := 0
+	for _, w := range weights {
+		if math.IsInf(w, 1) {
+			infs++
+		}
+	}
+	if infs > 0 {
+		for i, w := range weights {
+			if math.IsInf(w, 1) {
+				res[i] = 1.0 / float64(infs)
+			}
+		}
+		return res
+	}
+
+	sum := 0.0
+	for i, w := range weights {
+		if w > 0 {
+			res[i] = w
+			sum += w
+		}
+	}
+	if sum == 0 {
+		for i := range res {
+			res[i] = 1.0 / float64(len(res))
+		}
+		return res
+	}
+	for i := range res {
+		res[i] /= sum
+	}
+	return res
+}
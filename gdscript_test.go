@@ -1,18 +1,230 @@
 package zerogdscript
 
-import "testing"
-
-func TestMathgd_IsZeroApprox(t *testing.T) {}
-
-func TestMathgd_IsEqualApprox(t *testing.T) {}
+import (
+	"math"
+	"math/bits"
+	"strconv"
+	"testing"
+)
+
+func TestMathgd_IsFinitef(t *testing.T) {
+	for _, tt := range []struct {
+		x    float64
+		want bool
+	}{
+		{0, true}, {-1.5, true}, {math.MaxFloat64, true}, {math.SmallestNonzeroFloat64, true},
+		{math.NaN(), false}, {math.Inf(1), false}, {math.Inf(-1), false},
+	} {
+		if got := IsFinitef(tt.x); got != tt.want {
+			t.Errorf("IsFinitef(%v) = %v, want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_IsZeroApprox(t *testing.T) {
+	for _, tt := range []struct {
+		x    float64
+		want bool
+	}{
+		{0, true}, {math.Copysign(0, -1), true}, {CMP_EPSILON / 2, true}, {-CMP_EPSILON / 2, true},
+		{CMP_EPSILON, false}, {1, false},
+		{math.NaN(), false}, {math.Inf(1), false}, {math.Inf(-1), false},
+	} {
+		if got := IsZeroApprox(tt.x); got != tt.want {
+			t.Errorf("IsZeroApprox(%v) = %v, want %v", tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_IsEqualApprox(t *testing.T) {
+	nan, inf, ninf := math.NaN(), math.Inf(1), math.Inf(-1)
+	for _, tt := range []struct {
+		x, y float64
+		want bool
+	}{
+		{1, 1, true}, {1, 1 + CMP_EPSILON/2, true}, {1, 1 + CMP_EPSILON*2, false},
+		{nan, nan, false}, {nan, inf, false}, {nan, ninf, false}, {nan, 0, false}, {nan, 1e300, false},
+		{inf, inf, true}, {ninf, ninf, true}, {inf, ninf, false},
+		{inf, math.MaxFloat64, false}, {ninf, -math.MaxFloat64, false}, {inf, 0, false}, {ninf, 0, false},
+	} {
+		if got := IsEqualApprox(tt.x, tt.y); got != tt.want {
+			t.Errorf("IsEqualApprox(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+		// The comparison is symmetric.
+		if got := IsEqualApprox(tt.y, tt.x); got != tt.want {
+			t.Errorf("IsEqualApprox(%v, %v) = %v, want %v", tt.y, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_IsEqualApproxTol(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	for _, tt := range []struct {
+		x, y, tol float64
+		want      bool
+	}{
+		{1, 1.05, 0.1, true}, {1, 1.2, 0.1, false}, {-3, -3.09, 0.1, true},
+		{1e6, 1e6 + 0.5, 1, true}, {1e6, 1e6 + 2, 1, false},
+		{2, 2, 0, true}, {2, 2.0000001, 0, false},
+		{inf, inf, 1, true}, {inf, 1e300, 1e300, false}, {nan, nan, 1, false},
+	} {
+		if got := IsEqualApproxTol(tt.x, tt.y, tt.tol); got != tt.want {
+			t.Errorf("IsEqualApproxTol(%v, %v, %v) = %v, want %v", tt.x, tt.y, tt.tol, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_IsEqualApproxRelative(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	for _, tt := range []struct {
+		x, y float64
+		want bool
+	}{
+		// Small values keep the absolute CMP_EPSILON floor.
+		{0, CMP_EPSILON / 2, true}, {0, CMP_EPSILON * 2, false},
+		{0.5, 0.5 + CMP_EPSILON/2, true}, {0.5, 0.5 + CMP_EPSILON*2, false},
+		// Large values scale the tolerance: 1e6 allows a difference below 10.
+		{1e6, 1e6 + 5, true}, {1e6, 1e6 - 5, true}, {1e6, 1e6 + 20, false},
+		{-1e6, -1e6 - 5, true}, {-1e6, -1e6 + 20, false},
+		{1e15, 1e15 + 0.125, true},
+		{inf, inf, true}, {inf, 1e300, false}, {nan, nan, false}, {1e6, nan, false},
+	} {
+		if got := IsEqualApproxRelative(tt.x, tt.y); got != tt.want {
+			t.Errorf("IsEqualApproxRelative(%v, %v) = %v, want %v", tt.x, tt.y, got, tt.want)
+		}
+	}
+	// The fixed tolerance cannot compare values this large.
+	if IsEqualApprox(1e15, 1e15+0.125) {
+		t.Errorf("IsEqualApprox(1e15, 1e15+0.125) = true, want false")
+	}
+}
 
 func TestMathgd_Sign(t *testing.T) {}
 
 func TestMathgd_Clampi(t *testing.T) {}
 
+func TestMathgd_Absi(t *testing.T) {
+	for _, tt := range [][2]int{{5, 5}, {-5, 5}, {0, 0}, {math.MaxInt, math.MaxInt}, {-math.MaxInt, math.MaxInt}, {math.MinInt, math.MinInt}} {
+		if got := Absi(tt[0]); got != tt[1] {
+			t.Errorf("Absi(%d) = %d, want %d", tt[0], got, tt[1])
+		}
+	}
+}
+
+func TestMathgd_Signi(t *testing.T) {
+	for _, tt := range [][2]int{{7, 1}, {-7, -1}, {0, 0}, {math.MaxInt, 1}, {math.MinInt, -1}} {
+		if got := Signi(tt[0]); got != tt[1] {
+			t.Errorf("Signi(%d) = %d, want %d", tt[0], got, tt[1])
+		}
+	}
+}
+
+func TestMathgd_Posmodi(t *testing.T) {
+	for _, tt := range []struct{ x, y, want int }{
+		{7, 3, 1}, {-7, 3, 2}, {7, -3, -2}, {-7, -3, -1},
+		{6, 3, 0}, {-6, 3, 0}, {6, -3, 0}, {0, -3, 0},
+		{5, 0, 0},
+		{math.MinInt, 3, 1}, {math.MinInt, -3, -2},
+		{math.MinInt, -1, 0}, {math.MaxInt, math.MinInt, -1},
+	} {
+		if got := Posmodi(tt.x, tt.y); got != tt.want {
+			t.Errorf("Posmodi(%d, %d) = %d, want %d", tt.x, tt.y, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_NearestPo2(t *testing.T) {
+	// The largest power of two an int holds: 1<<62 on 64-bit, 1<<30 on 32-bit.
+	top := 1 << (bits.UintSize - 2)
+	for _, tt := range []struct{ v, want int }{
+		{-5, 0}, {0, 0}, {1, 1}, {2, 2}, {3, 4}, {5, 8}, {1023, 1024}, {1024, 1024}, {1025, 2048},
+		{top/2 + 1, top}, {top - 1, top}, {top, top},
+		{top + 1, 0}, {math.MaxInt, 0}, {math.MinInt, 0},
+	} {
+		if got := NearestPo2(tt.v); got != tt.want {
+			t.Errorf("NearestPo2(%d) = %d, want %d", tt.v, got, tt.want)
+		}
+	}
+}
+
 func TestMathgd_Clampf(t *testing.T) {}
 
-func TestMathgd_Snapped(t *testing.T) {}
+func TestMathgd_Snapped(t *testing.T) {
+	for _, tt := range []struct{ value, step, want float64 }{
+		{2.4, 1, 2}, {2.6, 1, 3}, {-2.4, 1, -2}, {-2.6, 1, -3},
+		{0.3, 0.25, 0.25}, {-0.3, 0.25, -0.25}, {7, 5, 5}, {-8, 5, -10},
+		// Halfway values round away from zero.
+		{2.5, 1, 3}, {-2.5, 1, -3}, {0.5, 1, 1}, {-0.5, 1, -1}, {0.375, 0.25, 0.5}, {-0.375, 0.25, -0.5},
+		// A negative step snaps like its absolute value.
+		{2.4, -1, 2}, {2.5, -1, 3}, {-2.5, -1, -3}, {-8, -5, -10}, {0.3, -0.25, 0.25},
+		// A zero step leaves the value alone, as in Godot.
+		{3, 0, 3}, {-2.7, 0, -2.7},
+	} {
+		if got := Snapped(tt.value, tt.step); got != tt.want {
+			t.Errorf("Snapped(%v, %v) = %v, want %v", tt.value, tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_Snappedi(t *testing.T) {
+	for _, tt := range []struct{ value, step, want int }{
+		{7, 5, 5}, {8, 5, 10}, {-7, 5, -5}, {-8, 5, -10}, {10, 5, 10}, {-10, 5, -10}, {0, 5, 0},
+		// Halfway values round away from zero.
+		{5, 10, 10}, {-5, 10, -10}, {15, 10, 20}, {-15, 10, -20}, {1, 2, 2}, {-1, 2, -2},
+		// A negative step snaps like its absolute value.
+		{7, -5, 5}, {-8, -5, -10}, {5, -10, 10}, {-5, -10, -10},
+		{7, 0, 7}, {-7, 0, -7},
+		{math.MaxInt - 1, math.MaxInt, math.MaxInt},
+	} {
+		if got := Snappedi(tt.value, tt.step); got != tt.want {
+			t.Errorf("Snappedi(%v, %v) = %v, want %v", tt.value, tt.step, got, tt.want)
+		}
+		if tt.value > -1000 && tt.value < 1000 {
+			if f := Snapped(float64(tt.value), float64(tt.step)); f != float64(tt.want) {
+				t.Errorf("Snapped(%v, %v) = %v, want it to match Snappedi's %v", tt.value, tt.step, f, tt.want)
+			}
+		}
+	}
+}
+
+func TestMathgd_StepDecimals(t *testing.T) {
+	for _, tt := range []struct {
+		step float64
+		want int
+	}{
+		{5, 0}, {1, 0}, {0.5, 1}, {0.1, 1}, {0.25, 1}, {0.01, 2}, {0.05, 2},
+		{0.001, 3}, {-0.001, 3}, {2.5, 1}, {0.00000001, 8}, {0.000000001, 9}, {0.0000000001, 0},
+	} {
+		if got := StepDecimals(tt.step); got != tt.want {
+			t.Errorf("StepDecimals(%v) = %d, want %d", tt.step, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_SnappedDecimals(t *testing.T) {
+	for _, tt := range []struct {
+		value, step float64
+		want        string
+	}{
+		{0.3, 0.1, "0.3"},
+		{0.7, 0.1, "0.7"},
+		{1.15, 0.05, "1.15"},
+		{-1.26, 0.01, "-1.26"},
+		{12.345, 0.001, "12.345"},
+		{17, 5, "15"},
+	} {
+		got := SnappedDecimals(Snapped(tt.value, tt.step), StepDecimals(tt.step))
+		if s := strconv.FormatFloat(got, 'g', -1, 64); s != tt.want {
+			t.Errorf("SnappedDecimals(Snapped(%v, %v)) = %s, want %s", tt.value, tt.step, s, tt.want)
+		}
+	}
+	if got := SnappedDecimals(1.23456, -1); got != 1 {
+		t.Errorf("SnappedDecimals(1.23456, -1) = %v, want 1", got)
+	}
+	if got := SnappedDecimals(math.Inf(1), 2); !math.IsInf(got, 1) {
+		t.Errorf("SnappedDecimals(+Inf, 2) = %v, want +Inf", got)
+	}
+}
 
 func TestMathgd_Fposmod(t *testing.T) {}
 
@@ -22,8 +234,95 @@ func TestMathgd_RadToDeg(t *testing.T) {}
 
 func TestMathgd_Lerp(t *testing.T) {}
 
+func TestMathgd_LerpClamped(t *testing.T) {
+	for _, tt := range []struct{ from, to, weight, want float64 }{
+		{0, 10, 0.25, 2.5},
+		{0, 10, 0, 0},
+		{0, 10, 1, 10},
+		{0, 10, 1.5, 10},
+		{0, 10, 1e9, 10},
+		{0, 10, -3, 0},
+		{0, 10, math.Inf(1), 10},
+		// Reversed ranges clamp to their own ends.
+		{10, 0, 0.25, 7.5},
+		{10, 0, 40, 0},
+		{10, 0, -40, 10},
+		{-2, -6, 2, -6},
+	} {
+		if got := LerpClamped(tt.from, tt.to, tt.weight); !IsEqualApprox(got, tt.want) {
+			t.Errorf("LerpClamped(%v, %v, %v) = %v, want %v", tt.from, tt.to, tt.weight, got, tt.want)
+		}
+	}
+}
+
 func TestMathgd_CubicInterpolate(t *testing.T) {}
 
+func TestMathgd_CatmullRom(t *testing.T) {
+	points := [][4]float64{{0, 1, 2, 3}, {-3, 0.5, 4, -1}, {10, -2, 7.25, 7.25}}
+	for _, p := range points {
+		for _, w := range []float64{0, 0.1, 0.25, 0.5, 0.75, 1} {
+			// Tension 0.5 is the uniform spline CubicInterpolate uses.
+			if got, want := CatmullRom(p[0], p[1], p[2], p[3], w, 0.5), CubicInterpolate(p[1], p[2], p[0], p[3], w); !IsEqualApprox(got, want) {
+				t.Errorf("CatmullRom(%v, %v, 0.5) = %v, CubicInterpolate gives %v", p, w, got, want)
+			}
+		}
+		for _, tension := range []float64{0, 0.25, 0.5, 1} {
+			if got := CatmullRom(p[0], p[1], p[2], p[3], 0, tension); !IsEqualApprox(got, p[1]) {
+				t.Errorf("CatmullRom(%v, 0, %v) = %v, want p1 %v", p, tension, got, p[1])
+			}
+			if got := CatmullRom(p[0], p[1], p[2], p[3], 1, tension); !IsEqualApprox(got, p[2]) {
+				t.Errorf("CatmullRom(%v, 1, %v) = %v, want p2 %v", p, tension, got, p[2])
+			}
+		}
+	}
+	// Tension 0 has flat tangents: the segment is a smoothstep from p1 to p2.
+	for _, w := range []float64{0.2, 0.5, 0.9} {
+		if got, want := CatmullRom(-5, 1, 3, 9, w, 0), 1+2*Smoothstep(0, 1, w); !IsEqualApprox(got, want) {
+			t.Errorf("CatmullRom(tension 0, %v) = %v, want %v", w, got, want)
+		}
+	}
+}
+
+func TestMathgd_CatmullRomCentripetal(t *testing.T) {
+	// Evenly spaced points give equal knots, so the curve is the uniform one.
+	for _, w := range []float64{0, 0.3, 0.5, 0.8, 1} {
+		if got, want := CatmullRomCentripetal(0, 2, 4, 6, w), CatmullRom(0, 2, 4, 6, w, 0.5); !IsEqualApprox(got, want) {
+			t.Errorf("CatmullRomCentripetal(even, %v) = %v, want %v", w, got, want)
+		}
+	}
+	// A distant p0 makes the uniform spline overshoot p2 and come back; the
+	// centripetal one stays monotonic between p1 and p2.
+	overshoot, prev := false, 0.0
+	for w := 0.0; w <= 1; w += 0.05 {
+		if CatmullRom(-10, 0, 1, 2, w, 0.5) > 1 {
+			overshoot = true
+		}
+		got := CatmullRomCentripetal(-10, 0, 1, 2, w)
+		if got < prev-1e-12 || got > 1+1e-12 {
+			t.Errorf("CatmullRomCentripetal(-10, 0, 1, 2, %v) = %v, want monotonic in [0, 1]", w, got)
+		}
+		prev = got
+	}
+	if !overshoot {
+		t.Errorf("uniform CatmullRom did not overshoot; the test points no longer exercise the difference")
+	}
+	// Repeated control points still give a finite curve through p1 and p2.
+	for _, p := range [][4]float64{{1, 1, 2, 3}, {0, 1, 1, 3}, {0, 1, 2, 2}, {4, 4, 4, 4}} {
+		for _, w := range []float64{0, 0.5, 1} {
+			got := CatmullRomCentripetal(p[0], p[1], p[2], p[3], w)
+			if !IsFinitef(got) {
+				t.Errorf("CatmullRomCentripetal(%v, %v) = %v, want finite", p, w, got)
+			}
+		}
+		if got := CatmullRomCentripetal(p[0], p[1], p[2], p[3], 0); !IsEqualApprox(got, p[1]) {
+			t.Errorf("CatmullRomCentripetal(%v, 0) = %v, want %v", p, got, p[1])
+		}
+		if got := CatmullRomCentripetal(p[0], p[1], p[2], p[3], 1); !IsEqualApprox(got, p[2]) {
+			t.Errorf("CatmullRomCentripetal(%v, 1) = %v, want %v", p, got, p[2])
+		}
+	}
+}
+
 func TestMathgd_CubicInterpolateAngle(t *testing.T) {}
 
 func TestMathgd_CubicInterpolateInTime(t *testing.T) {}
@@ -34,23 +333,535 @@ func TestMathgd_BezierInterpolate(t *testing.T) {}
 
 func TestMathgd_BezierDerivative(t *testing.T) {}
 
+func TestMathgd_BezierLength(t *testing.T) {
+	// Evenly spaced control points move at constant speed: length 3.
+	if got := BezierLength(0, 1, 2, 3, 1); !IsEqualApprox(got, 3) {
+		t.Errorf("BezierLength(line) = %v, want 3", got)
+	}
+	// A scalar curve that turns back covers the distance both ways:
+	// 0, 1, 1, 0 rises to 0.75 and falls back, 1.5 in total.
+	if got := BezierLength(0, 1, 1, 0, 16); !IsEqualApprox(got, 1.5) {
+		t.Errorf("BezierLength(0, 1, 1, 0) = %v, want 1.5", got)
+	}
+	// Subdivisions below 1 count as one.
+	if got, want := BezierLength(0, 1, 2, 3, 0), BezierLength(0, 1, 2, 3, 1); got != want {
+		t.Errorf("BezierLength(0 subdivisions) = %v, want %v", got, want)
+	}
+}
+
+func TestMathgd_BezierSampleByDistance(t *testing.T) {
+	for _, d := range []float64{0.3, 1.5, 2.9} {
+		if got := BezierSampleByDistance(0, 1, 2, 3, d); !IsEqualApprox(got, d/3) {
+			t.Errorf("BezierSampleByDistance(line, %v) = %v, want %v", d, got, d/3)
+		}
+	}
+	// An eased curve: the sampled t lands on the requested distance.
+	for _, d := range []float64{0.1, 0.5, 0.9} {
+		tt := BezierSampleByDistance(0, 0, 1, 1, d)
+		if got := BezierInterpolate(0, 0, 1, 1, tt); !IsEqualApprox(got, d) {
+			t.Errorf("BezierSampleByDistance(ease, %v) = t %v at %v", d, tt, got)
+		}
+	}
+	if got := BezierSampleByDistance(0, 1, 2, 3, -1); got != 0 {
+		t.Errorf("BezierSampleByDistance(-1) = %v, want 0", got)
+	}
+	if got := BezierSampleByDistance(0, 1, 2, 3, 10); got != 1 {
+		t.Errorf("BezierSampleByDistance(past the end) = %v, want 1", got)
+	}
+}
+
+func TestMathgd_ArcLength(t *testing.T) {
+	// A unit circle traced at speed 2*PI.
+	speed := func(float64) float64 { return TAU }
+	if got := ArcLength(speed, 0, 0.5, 1); !IsEqualApprox(got, math.Pi) {
+		t.Errorf("ArcLength(circle, 0, 0.5) = %v, want PI", got)
+	}
+	// The speed of t^3 on [0, 1] is 3t^2, which five-point quadrature integrates exactly.
+	if got := ArcLength(func(t float64) float64 { return 3 * t * t }, 0, 1, 1); !IsEqualApprox(got, 1) {
+		t.Errorf("ArcLength(3t^2) = %v, want 1", got)
+	}
+	// A speed with a kink at 1/3 needs the adaptive inverse to be exact.
+	kink := func(t float64) float64 { return math.Abs(t - 1.0/3.0) }
+	total := 1.0/18 + 2.0/9 // integral of |t - 1/3| over [0, 1]
+	for _, d := range []float64{0.01, 1.0 / 18, 0.2} {
+		tt := ArcLengthParameter(kink, d, 1e-10)
+		var got float64
+		if tt <= 1.0/3 {
+			got = (1.0/9 - (1.0/3-tt)*(1.0/3-tt)) / 2
+		} else {
+			got = 1.0/18 + (tt-1.0/3)*(tt-1.0/3)/2
+		}
+		if math.Abs(got-d) > 1e-9 {
+			t.Errorf("ArcLengthParameter(kink, %v) = %v, where the length is %v", d, tt, got)
+		}
+	}
+	if got := ArcLengthParameter(kink, total+1, 0); got != 1 {
+		t.Errorf("ArcLengthParameter(past the end) = %v, want 1", got)
+	}
+}
+
+func TestMathgd_BezierSecondDerivative(t *testing.T) {
+	// B(t) = t^3 with control points 0, 0, 0, 1: B'' = 6t.
+	for _, w := range []float64{0, 0.25, 0.5, 1} {
+		if got := BezierSecondDerivative(0, 0, 0, 1, w); !IsEqualApprox(got, 6*w) {
+			t.Errorf("BezierSecondDerivative(t^3, %v) = %v, want %v", w, got, 6*w)
+		}
+	}
+	// It matches a central difference of BezierDerivative.
+	const h = 1e-6
+	for _, w := range []float64{0.1, 0.4, 0.9} {
+		numeric := (BezierDerivative(1, -2, 5, 3, w+h) - BezierDerivative(1, -2, 5, 3, w-h)) / (2 * h)
+		if got := BezierSecondDerivative(1, -2, 5, 3, w); math.Abs(got-numeric) > 1e-5 {
+			t.Errorf("BezierSecondDerivative(%v) = %v, numeric %v", w, got, numeric)
+		}
+	}
+}
+
+func TestMathgd_BezierCurvature(t *testing.T) {
+	// A straight ramp has no curvature.
+	if got := BezierCurvature(0, 1, 2, 3, 0.3); !IsZeroApprox(got) {
+		t.Errorf("BezierCurvature(line) = %v, want 0", got)
+	}
+	// t^3 at t=0 has a zero slope and zero second derivative; at t=0.5 the
+	// slope is 0.75 and the second derivative 3.
+	if got := BezierCurvature(0, 0, 0, 1, 0); got != 0 {
+		t.Errorf("BezierCurvature(t^3, 0) = %v, want 0", got)
+	}
+	if got, want := BezierCurvature(0, 0, 0, 1, 0.5), 3/math.Pow(1+0.75*0.75, 1.5); !IsEqualApprox(got, want) {
+		t.Errorf("BezierCurvature(t^3, 0.5) = %v, want %v", got, want)
+	}
+	// Bending down is negative.
+	if got := BezierCurvature(0, 1, 1, 0, 0.5); got >= 0 {
+		t.Errorf("BezierCurvature(hump, 0.5) = %v, want negative", got)
+	}
+}
+
 func TestMathgd_AngleDifference(t *testing.T) {}
 
 func TestMathgd_LerpAngle(t *testing.T) {}
 
-func TestMathgd_InverseLerp(t *testing.T) {}
-
-func TestMathgd_Remap(t *testing.T) {}
+func TestMathgd_InverseLerp(t *testing.T) {
+	for _, tt := range []struct{ from, to, value, want float64 }{
+		{0, 10, 2.5, 0.25},
+		{0, 10, -10, -1},
+		{0, 10, 1e9, 1e8},
+		// Reversed ranges.
+		{10, 0, 2.5, 0.75},
+		{10, 0, 20, -1},
+		{-2, -6, -5, 0.75},
+		// An empty range returns 0 instead of Inf or NaN.
+		{3, 3, 3, 0},
+		{3, 3, 7, 0},
+		{3, 3, -7, 0},
+	} {
+		if got := InverseLerp(tt.from, tt.to, tt.value); !IsEqualApprox(got, tt.want) {
+			t.Errorf("InverseLerp(%v, %v, %v) = %v, want %v", tt.from, tt.to, tt.value, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_Remap(t *testing.T) {
+	for _, tt := range []struct {
+		name                                string
+		value, istart, istop, ostart, ostop float64
+		want, wantClamped                   float64
+	}{
+		{"inside", 5, 0, 10, 100, 200, 150, 150},
+		{"below", -5, 0, 10, 100, 200, 50, 100},
+		{"above", 15, 0, 10, 100, 200, 250, 200},
+		{"reversed input", 2, 10, 0, 0, 1, 0.8, 0.8},
+		{"reversed input above", 12, 10, 0, 0, 1, -0.2, 0},
+		{"reversed input below", -1, 10, 0, 0, 1, 1.1, 1},
+		{"reversed output", 0.25, 0, 1, 1, -1, 0.5, 0.5},
+		{"both reversed", 0.75, 1, 0, 10, 0, 7.5, 7.5},
+		{"both reversed outside", 2, 1, 0, 10, 0, 20, 10},
+		{"empty input range", 3, 4, 4, -1, 1, -1, -1},
+		{"empty input range at value", 4, 4, 4, -1, 1, -1, -1},
+	} {
+		if got := Remap(tt.value, tt.istart, tt.istop, tt.ostart, tt.ostop); !IsEqualApprox(got, tt.want) {
+			t.Errorf("%s: Remap = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := RemapClamped(tt.value, tt.istart, tt.istop, tt.ostart, tt.ostop); !IsEqualApprox(got, tt.wantClamped) {
+			t.Errorf("%s: RemapClamped = %v, want %v", tt.name, got, tt.wantClamped)
+		}
+	}
+}
 
 func TestMathgd_Smoothstep(t *testing.T) {}
 
+func TestMathgd_SmoothMin(t *testing.T) {
+	for _, tt := range []struct {
+		a, b, k, wantMin, wantMax float64
+	}{
+		// Far apart relative to k: exactly min and max.
+		{1, 3, 1, 1, 3}, {3, 1, 1, 1, 3}, {-2, 5, 4, -2, 5},
+		// Equal values blend by k/4.
+		{2, 2, 1, 1.75, 2.25}, {0, 0, 4, -1, 1},
+		// Half of k apart: h = 0.5, offset k/16.
+		{1, 1.5, 1, 0.9375, 1.5625},
+		// No smoothing.
+		{1, 1.5, 0, 1, 1.5}, {1, 1.5, -1, 1, 1.5},
+	} {
+		if got := SmoothMin(tt.a, tt.b, tt.k); !IsEqualApprox(got, tt.wantMin) {
+			t.Errorf("SmoothMin(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.k, got, tt.wantMin)
+		}
+		if got := SmoothMax(tt.a, tt.b, tt.k); !IsEqualApprox(got, tt.wantMax) {
+			t.Errorf("SmoothMax(%v, %v, %v) = %v, want %v", tt.a, tt.b, tt.k, got, tt.wantMax)
+		}
+	}
+
+	// As k shrinks the result approaches math.Min.
+	prev := math.Inf(1)
+	for _, k := range []float64{1, 0.1, 0.01, 0.001, 1e-6} {
+		d := math.Abs(SmoothMin(0.7, 0.7, k) - 0.7)
+		if d >= prev {
+			t.Errorf("SmoothMin error %v at k=%v did not shrink from %v", d, k, prev)
+		}
+		prev = d
+	}
+	if prev > 1e-6 {
+		t.Errorf("SmoothMin(0.7, 0.7, 1e-6) is %v from math.Min", prev)
+	}
+
+	// The blend is continuous and rounded: sweeping b through a never jumps
+	// and stays at or below the hard minimum.
+	last := SmoothMin(0, -2, 1)
+	for b := -2.0; b <= 2; b += 0.01 {
+		got := SmoothMin(0, b, 1)
+		if got > math.Min(0, b) {
+			t.Fatalf("SmoothMin(0, %v, 1) = %v, above math.Min", b, got)
+		}
+		if math.Abs(got-last) > 0.011 {
+			t.Fatalf("SmoothMin jumps from %v to %v at b=%v", last, got, b)
+		}
+		last = got
+	}
+}
+
+func TestMathgd_Step(t *testing.T) {
+	for _, tt := range []struct {
+		edge, x, want float64
+	}{
+		{0.5, 0.4, 0}, {0.5, 0.5, 1}, {0.5, 0.6, 1}, {-1, -2, 0}, {-1, 0, 1},
+	} {
+		if got := Step(tt.edge, tt.x); got != tt.want {
+			t.Errorf("Step(%v, %v) = %v, want %v", tt.edge, tt.x, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_Smootherstep(t *testing.T) {
+	for _, tt := range []struct {
+		from, to, x, want float64
+	}{
+		{0, 1, 0, 0}, {0, 1, 1, 1}, {0, 1, 0.5, 0.5},
+		{0, 1, 0.25, 0.103515625}, {0, 1, 0.75, 0.896484375},
+		{0, 1, -2, 0}, {0, 1, 3, 1},
+		{2, 6, 3, 0.103515625}, {2, 6, 7, 1},
+		// A reversed range rises from 0 to 1 as x falls from 6 to 2.
+		{6, 2, 5, 0.103515625},
+		// A degenerate range returns from, as Smoothstep does.
+		{3, 3, 10, 3}, {3, 3, -10, 3},
+	} {
+		if got := Smootherstep(tt.from, tt.to, tt.x); !IsEqualApprox(got, tt.want) {
+			t.Errorf("Smootherstep(%v, %v, %v) = %v, want %v", tt.from, tt.to, tt.x, got, tt.want)
+		}
+	}
+	if got, want := Smootherstep(3, 3, 10), Smoothstep(3, 3, 10); got != want {
+		t.Errorf("Smootherstep(3, 3, 10) = %v, Smoothstep gives %v", got, want)
+	}
+}
+
+func TestMathgd_SmoothstepDerivative(t *testing.T) {
+	for _, tt := range []struct {
+		from, to, x, want float64
+	}{
+		{0, 1, 0.5, 1.5}, {0, 1, 0.25, 1.125},
+		{0, 1, 0, 0}, {0, 1, 1, 0}, {0, 1, -1, 0}, {0, 1, 2, 0},
+		// The slope scales with the inverse of the range width.
+		{2, 6, 4, 0.375}, {6, 2, 4, -0.375},
+		{3, 3, 3, 0}, {3, 3, 10, 0},
+	} {
+		if got := SmoothstepDerivative(tt.from, tt.to, tt.x); !IsEqualApprox(got, tt.want) {
+			t.Errorf("SmoothstepDerivative(%v, %v, %v) = %v, want %v", tt.from, tt.to, tt.x, got, tt.want)
+		}
+	}
+	// It matches a central difference of Smoothstep inside the range.
+	const h = 1e-6
+	for _, x := range []float64{2.3, 3.1, 4.9, 5.7} {
+		numeric := (Smoothstep(2, 6, x+h) - Smoothstep(2, 6, x-h)) / (2 * h)
+		if got := SmoothstepDerivative(2, 6, x); math.Abs(got-numeric) > 1e-6 {
+			t.Errorf("SmoothstepDerivative(2, 6, %v) = %v, numeric %v", x, got, numeric)
+		}
+	}
+}
+
 func TestMathgd_MoveToward(t *testing.T) {}
 
-func TestMathgd_RotateToward(t *testing.T) {}
+func TestMathgd_RotateToward(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		from, to, delta float64
+		want            float64
+	}{
+		{"counter-clockwise step", 0, 1, 0.25, 0.25},
+		{"clockwise step", 0, -1, 0.25, -0.25},
+		{"clockwise from positive", 1, 0.5, 0.1, 0.9},
+		{"overshoot clamps to target", 0, 0.5, 2, 0.5},
+		{"clockwise overshoot clamps", 0, -0.5, 2, -0.5},
+		{"already there", 1, 1, 0.3, 1},
+		{"wraps through PI", 3, -3, 0.1, 3.1},
+		{"wraps through -PI", -3, 3, 0.1, -3.1},
+		{"wrap overshoot", 3, -3, 1, 3 + (2*PI - 6)},
+		{"short way across zero", 0.1, TAU - 0.1, 0.05, 0.05},
+		{"negative delta moves away", 0, 1, -0.25, -0.25},
+		{"negative delta clockwise target", 0, -1, -0.25, 0.25},
+		{"negative delta stops opposite target", 0, 1, -10, 1 - PI},
+		{"negative delta at target", 1, 1, -10, 1 - PI},
+	} {
+		if got := RotateToward(tt.from, tt.to, tt.delta); math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("%s: RotateToward(%v, %v, %v) = %v, want %v", tt.name, tt.from, tt.to, tt.delta, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_AngleDeg(t *testing.T) {
+	// 359 to 1 is two degrees forwards through 0, not 358 backwards.
+	if got := AngleDifferenceDeg(359, 1); math.Abs(got-2) > 1e-12 {
+		t.Errorf("AngleDifferenceDeg(359, 1) = %v, want 2", got)
+	}
+	if got := AngleDifferenceDeg(1, 359); math.Abs(got+2) > 1e-12 {
+		t.Errorf("AngleDifferenceDeg(1, 359) = %v, want -2", got)
+	}
+	if got := WrapAngleDeg(LerpAngleDeg(359, 1, 0.5)); math.Abs(got) > 1e-12 {
+		t.Errorf("LerpAngleDeg(359, 1, 0.5) = %v, want 0 after wrapping", got)
+	}
+	if got := RotateTowardDeg(359, 1, 1); math.Abs(got-360) > 1e-12 {
+		t.Errorf("RotateTowardDeg(359, 1, 1) = %v, want 360", got)
+	}
+	if got := RotateTowardDeg(359, 1, 10); math.Abs(got-361) > 1e-12 {
+		t.Errorf("RotateTowardDeg(359, 1, 10) = %v, want 361", got)
+	}
+	if got := RotateTowardDeg(0, 10, -500); math.Abs(got+170) > 1e-12 {
+		t.Errorf("RotateTowardDeg(0, 10, -500) = %v, want -170", got)
+	}
+
+	// Exact in degrees where a trip through radians would not be.
+	if got := AngleDifferenceDeg(1e9+1, 1e9+359); got != -2 {
+		t.Errorf("AngleDifferenceDeg(1e9+1, 1e9+359) = %v, want -2", got)
+	}
+
+	// For small angles the degree versions agree with the radian ones.
+	for _, tt := range []struct{ from, to, w float64 }{
+		{10, 30, 0.25},
+		{-20, 15, 0.5},
+		{170, -170, 0.5},
+		{-90, 45, 1},
+		{350, 10, 0.75},
+	} {
+		from, to := DegToRad(tt.from), DegToRad(tt.to)
+		if got, want := AngleDifferenceDeg(tt.from, tt.to), RadToDeg(AngleDifference(from, to)); math.Abs(got-want) > 1e-9 {
+			t.Errorf("AngleDifferenceDeg(%v, %v) = %v, want %v", tt.from, tt.to, got, want)
+		}
+		if got, want := LerpAngleDeg(tt.from, tt.to, tt.w), RadToDeg(LerpAngle(from, to, tt.w)); math.Abs(got-want) > 1e-9 {
+			t.Errorf("LerpAngleDeg(%v, %v, %v) = %v, want %v", tt.from, tt.to, tt.w, got, want)
+		}
+		if got, want := RotateTowardDeg(tt.from, tt.to, 5), RadToDeg(RotateToward(from, to, DegToRad(5))); math.Abs(got-want) > 1e-9 {
+			t.Errorf("RotateTowardDeg(%v, %v, 5) = %v, want %v", tt.from, tt.to, got, want)
+		}
+		if got, want := WrapAngleDeg(tt.to), RadToDeg(WrapAngle(to)); math.Abs(got-want) > 1e-9 {
+			t.Errorf("WrapAngleDeg(%v) = %v, want %v", tt.to, got, want)
+		}
+	}
+}
+
+func TestMathgd_WrapAngle(t *testing.T) {
+	for _, tt := range []struct {
+		in, wrap, norm float64
+	}{
+		{0, 0, 0},
+		{PI, -PI, PI},
+		{-PI, -PI, PI},
+		{TAU, 0, 0},
+		{-TAU, 0, 0},
+		{PI / 2, PI / 2, PI / 2},
+		{-PI / 2, -PI / 2, 3 * PI / 2},
+		{3 * PI, -PI, PI},
+		{-1e-20, -1e-20, 0},
+	} {
+		if got := WrapAngle(tt.in); got != tt.wrap {
+			t.Errorf("WrapAngle(%v) = %v, want %v", tt.in, got, tt.wrap)
+		}
+		if got := NormalizeAngle(tt.in); got != tt.norm {
+			t.Errorf("NormalizeAngle(%v) = %v, want %v", tt.in, got, tt.norm)
+		}
+	}
+
+	// Huge angles land in range and still point the same way. The only error
+	// left is TAU's own rounding, scaled by the number of turns.
+	for _, a := range []float64{1e9, -1e9, 123456789.123, 1e15} {
+		tol := math.Abs(a) / TAU * 1e-15
+		w, n := WrapAngle(a), NormalizeAngle(a)
+		if w < -PI || w >= PI {
+			t.Errorf("WrapAngle(%v) = %v, out of [-PI, PI)", a, w)
+		}
+		if n < 0 || n >= TAU {
+			t.Errorf("NormalizeAngle(%v) = %v, out of [0, TAU)", a, n)
+		}
+		if math.Abs(math.Sin(w)-math.Sin(a)) > tol || math.Abs(math.Cos(w)-math.Cos(a)) > tol {
+			t.Errorf("WrapAngle(%v) = %v points elsewhere", a, w)
+		}
+		if math.Abs(math.Sin(n)-math.Sin(a)) > tol || math.Abs(math.Cos(n)-math.Cos(a)) > tol {
+			t.Errorf("NormalizeAngle(%v) = %v points elsewhere", a, n)
+		}
+	}
+
+	for _, tt := range []struct {
+		in, wrap, norm float64
+	}{
+		{180, -180, 180},
+		{-180, -180, 180},
+		{360, 0, 0},
+		{-90, -90, 270},
+		{540, -180, 180},
+		{360*1e7 + 45, 45, 45},
+		{-360*1e7 - 45, -45, 315},
+		{1e9, -80, 280},
+	} {
+		if got := WrapAngleDeg(tt.in); got != tt.wrap {
+			t.Errorf("WrapAngleDeg(%v) = %v, want %v", tt.in, got, tt.wrap)
+		}
+		if got := NormalizeAngleDeg(tt.in); got != tt.norm {
+			t.Errorf("NormalizeAngleDeg(%v) = %v, want %v", tt.in, got, tt.norm)
+		}
+	}
+}
+
+func TestMathgd_SmoothDamp(t *testing.T) {
+	const dt, target = 1.0 / 60, 10.0
+	x, v := 0.0, 0.0
+	settled := -1
+	for i := 0; i < 300; i++ {
+		prev := x
+		x = SmoothDamp(x, target, &v, 0.3, math.Inf(1), dt)
+		if x < prev || x > target {
+			t.Fatalf("frame %d: moved from %v to %v, want monotonic up to %v", i, prev, x, target)
+		}
+		if x == target && settled < 0 {
+			settled = i
+		}
+		if settled >= 0 && (x != target || v != 0) {
+			t.Fatalf("frame %d: left the target for %v moving %v after settling on frame %d", i, x, v, settled)
+		}
+	}
+	if settled < 0 {
+		t.Errorf("ended at %v moving %v, want exactly %v at rest", x, v, target)
+	}
+
+	// maxSpeed caps the velocity.
+	x, v = 0, 0
+	for i := 0; i < 120; i++ {
+		prev := x
+		x = SmoothDamp(x, target, &v, 0.3, 2, dt)
+		if speed := (x - prev) / dt; speed > 2+1e-9 || v > 2+1e-9 {
+			t.Fatalf("frame %d: moving at %v (velocity %v), want at most 2", i, speed, v)
+		}
+	}
+
+	// A zero smoothTime jumps straight to the target.
+	x, v = -5, 0
+	if x = SmoothDamp(x, target, &v, 0, math.Inf(1), dt); x != target || v != 0 {
+		t.Errorf("zero smoothTime gave %v moving %v, want %v at rest", x, v, target)
+	}
+
+	// No time passing changes nothing.
+	v = 3
+	if x = SmoothDamp(1, target, &v, 0.3, math.Inf(1), 0); x != 1 || v != 3 {
+		t.Errorf("zero delta gave %v moving %v, want 1 moving 3", x, v)
+	}
+}
+
+func TestMathgd_Spring(t *testing.T) {
+	const stiffness, dt, target = 100.0, 1.0 / 120, 1.0
+	run := func(damping float64) (maxValue float64, crossings int, x, v float64) {
+		for i := 0; i < 600; i++ {
+			prev := x
+			x, v = Spring(x, target, v, stiffness, damping, dt)
+			maxValue = math.Max(maxValue, x)
+			if (prev-target)*(x-target) < 0 {
+				crossings++
+			}
+		}
+		return maxValue, crossings, x, v
+	}
+
+	// Critical damping is 2*sqrt(100) = 20.
+	maxValue, crossings, x, v := run(40)
+	if maxValue > target {
+		t.Errorf("overdamped spring overshot to %v", maxValue)
+	}
+	if math.Abs(x-target) > 1e-3 || math.Abs(v) > 1e-3 {
+		t.Errorf("overdamped spring ended at %v moving %v, want %v at rest", x, v, target)
+	}
+
+	maxValue, crossings, x, v = run(4)
+	if maxValue <= target || crossings < 4 {
+		t.Errorf("underdamped spring peaked at %v and crossed the target %d times, want it to oscillate", maxValue, crossings)
+	}
+	if math.Abs(x-target) > 1e-3 || math.Abs(v) > 1e-3 {
+		t.Errorf("underdamped spring ended at %v moving %v, want %v at rest", x, v, target)
+	}
+
+	if x, v := Spring(target, target, 0, stiffness, 5, dt); x != target || v != 0 {
+		t.Errorf("spring at rest on the target moved to %v with velocity %v", x, v)
+	}
+}
 
 func TestMathgd_LinearToDb(t *testing.T) {}
 
-func TestMathgd_DbToLinear(t *testing.T) {}
+func TestMathgd_LinearToDbClamped(t *testing.T) {
+	const silence = -80.0
+	for _, tt := range []struct {
+		in, want float64
+	}{
+		{0, silence},
+		{math.Copysign(0, -1), silence},
+		{-0.5, silence},
+		{math.NaN(), silence},
+		{math.SmallestNonzeroFloat64, silence},
+		{0x1p-1030, silence}, // denormal
+		{1e-5, silence},      // -100 dB
+		{1, 0},
+		{0.5, LinearToDb(0.5)},
+		{2, LinearToDb(2)},
+		{math.Inf(1), math.Inf(1)},
+	} {
+		if got := LinearToDbClamped(tt.in, silence); got != tt.want {
+			t.Errorf("LinearToDbClamped(%v) = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+	if got := LinearToDbClamped(1e-3, silence); !IsEqualApprox(got, -60) {
+		t.Errorf("LinearToDbClamped(1e-3) = %v, want -60", got)
+	}
+}
+
+func TestMathgd_DbToLinear(t *testing.T) {
+	// Silence round-trips through the raw functions exactly.
+	if got := DbToLinear(LinearToDb(0)); got != 0 {
+		t.Errorf("DbToLinear(LinearToDb(0)) = %v, want 0", got)
+	}
+	if got := DbToLinear(math.Inf(-1)); got != 0 {
+		t.Errorf("DbToLinear(-Inf) = %v, want 0", got)
+	}
+	if got := DbToLinear(-80); !IsEqualApproxTol(got, 1e-4, 1e-12) {
+		t.Errorf("DbToLinear(-80) = %v, want 1e-4", got)
+	}
+	for _, linear := range []float64{1e-4, 0.25, 1, 3} {
+		if got := DbToLinear(LinearToDbClamped(linear, -80)); !IsEqualApprox(got, linear) {
+			t.Errorf("DbToLinear(LinearToDbClamped(%v)) = %v", linear, got)
+		}
+	}
+}
 
 func TestMathgd_Wrapi(t *testing.T) {}
 
@@ -58,8 +869,86 @@ func TestMathgd_Wrapf(t *testing.T) {}
 
 func TestMathgd_Fract(t *testing.T) {}
 
-func TestMathgd_Pingpong(t *testing.T) {}
-
-func TestMathgd_SnapScalar(t *testing.T) {}
-
-func TestMathgd_SnapScalarSeparation(t *testing.T) {}
+func TestMathgd_Pingpong(t *testing.T) {
+	nan, inf := math.NaN(), math.Inf(1)
+	for _, tt := range []struct{ value, length, want float64 }{
+		{5, 0, 0}, {-5, 0, 0}, {0, 0, 0},
+		{1, 3, 1}, {4, 3, 2}, {7, 3, 1}, {-1, 3, 1},
+		// Negative values mirror the positive side.
+		{-2, 3, 2}, {-4, 3, 2}, {-5.5, 3, 0.5}, {-7, 3, 1},
+		// Negative lengths behave like their absolute value.
+		{1, -3, 1}, {4, -3, 2}, {-1, -3, 1}, {-4, -3, 2},
+		// Exact multiples: 0 at even multiples, the length at odd ones.
+		{0, 3, 0}, {3, 3, 3}, {6, 3, 0}, {9, 3, 3}, {-3, 3, 3}, {-6, 3, 0},
+		{0.3, 0.1, 0.1}, {0.6, 0.1, 0}, {-0.6, 0.1, 0},
+		// Many periods away from zero.
+		{6e8 + 1, 3, 1}, {-6e8 - 1, 3, 1}, {6e8 + 4, 3, 2}, {1e15 + 0.5, 0.5, 0.5},
+		// Non-finite input.
+		{nan, 3, 0}, {1, nan, 0}, {inf, 3, 0}, {-inf, 3, 0}, {1, inf, 0},
+	} {
+		if got := Pingpong(tt.value, tt.length); !IsEqualApprox(got, tt.want) {
+			t.Errorf("Pingpong(%v, %v) = %v, want %v", tt.value, tt.length, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_SnapScalar(t *testing.T) {
+	for _, tt := range []struct {
+		offset, step, target, want float64
+	}{
+		{0, 1, 2.4, 2}, {0, 1, 2.6, 3}, {0, 0.25, 0.3, 0.25},
+		{0.1, 0.5, 0.3, 0.1}, {0.1, 0.5, 0.4, 0.6}, {0.1, 0.5, -0.3, -0.4},
+		{0.1, 0.5, 1.1, 1.1}, {0.1, 0.5, 0.84, 0.6}, {0.1, 0.5, 0.86, 1.1},
+		{3, 2, 0.2, 1}, {-1, 4, 6.5, 7},
+		{0, 0, 2.4, 2.4}, {5, 0, -1.7, -1.7},
+	} {
+		if got := SnapScalar(tt.offset, tt.step, tt.target); !IsEqualApprox(got, tt.want) {
+			t.Errorf("SnapScalar(%v, %v, %v) = %v, want %v", tt.offset, tt.step, tt.target, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_SnapScalarSeparation(t *testing.T) {
+	// Cells of size 1 with 0.5 gaps: edges at ..., -1.5, -0.5, 0, 1, 1.5, 2.5, 3, ...
+	for _, tt := range []struct {
+		offset, step, target, separation, want float64
+	}{
+		{0, 1, 1.6, 0.5, 1.5}, {0, 1, 1.1, 0.5, 1}, {0, 1, 0.2, 0.5, 0},
+		{0, 1, 2.7, 0.5, 2.5}, {0, 1, -1.1, 0.5, -1.5}, {0, 1, -0.9, 0.5, -0.5},
+		{10, 1, 11.1, 0.5, 11}, {0, 1, 1.6, 0, 2},
+		{0, 0, 1.6, 0.5, 1.6},
+	} {
+		if got := SnapScalarSeparation(tt.offset, tt.step, tt.target, tt.separation); !IsEqualApprox(got, tt.want) {
+			t.Errorf("SnapScalarSeparation(%v, %v, %v, %v) = %v, want %v", tt.offset, tt.step, tt.target, tt.separation, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_NormalizeWeights(t *testing.T) {
+	tests := []struct {
+		name    string
+		weights []float64
+		want    []float64
+	}{
+		{"normal", []float64{1, 3, 4}, []float64{0.125, 0.375, 0.5}},
+		{"negatives", []float64{-2, 1, 3}, []float64{0, 0.25, 0.75}},
+		{"all zero", []float64{0, 0, 0, 0}, []float64{0.25, 0.25, 0.25, 0.25}},
+		{"all non-positive", []float64{0, -1}, []float64{0.5, 0.5}},
+		{"empty", []float64{}, []float64{}},
+		{"NaN", []float64{math.NaN(), 1, 3}, []float64{0, 0.25, 0.75}},
+		{"-Inf", []float64{math.Inf(-1), 1}, []float64{0, 1}},
+		{"+Inf dominates", []float64{math.Inf(1), 5, math.Inf(1)}, []float64{0.5, 0, 0.5}},
+	}
+	for _, tt := range tests {
+		got := NormalizeWeights(tt.weights)
+		if len(got) != len(tt.want) {
+			t.Fatalf("%s: NormalizeWeights(%v) = %v, want %v", tt.name, tt.weights, got, tt.want)
+		}
+		for i := range tt.want {
+			if !IsEqualApprox(got[i], tt.want[i]) {
+				t.Errorf("%s: NormalizeWeights(%v) = %v, want %v", tt.name, tt.weights, got, tt.want)
+				break
+			}
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Package core holds the tolerance constants and scalar and matrix helpers shared by the
+// root package and mathgd32. Both re-export them, so the float64 and float32
+// APIs always agree on what "approximately equal" means.
+package core
+
+import "math"
+
+// CMP_EPSILON represents the tolerance value used for floating-point comparison.
+// Godot uses the same value for single- and double-precision builds.
+const CMP_EPSILON = 0.00001
+
+// CMP_EPSILON2 represents the square of CMP_EPSILON.
+const CMP_EPSILON2 = CMP_EPSILON * CMP_EPSILON
+
+// CMP_NORMALIZE_TOLERANCE represents the tolerance value used for normalizing vectors.
+const CMP_NORMALIZE_TOLERANCE = 0.000001
+
+// CMP_POINT_IN_PLANE_EPSILON represents the tolerance value used for checking if a point lies on a plane.
+const CMP_POINT_IN_PLANE_EPSILON = 0.00001
+
+// UNIT_EPSILON is the looser tolerance Godot uses when checking that vectors
+// and quaternions are unit length.
+const UNIT_EPSILON = 0.001
+
+// TAU represents the mathematical constant Tau (2 * Pi).
+const TAU = 6.2831853071795864769252867666
+
+// PI represents the mathematical constant Pi.
+const PI = 3.1415926535897932384626433833
+
+// Float is the set of types the helpers work on.
+type Float interface {
+	~float32 | ~float64
+}
+
+// IsFinitef reports whether x is neither NaN nor an infinity.
+func IsFinitef[T Float](x T) bool {
+	return !math.IsNaN(float64(x)) && !math.IsInf(float64(x), 0)
+}
+
+// IsZeroApprox checks if a floating-point number is approximately zero within a certain tolerance.
+// NaN and infinities are never approximately zero.
+func IsZeroApprox[T Float](x T) bool {
+	return math.Abs(float64(x)) < CMP_EPSILON
+}
+
+// IsEqualApprox checks if two floating-point numbers are approximately equal within a certain tolerance.
+// As in Godot, an infinity equals only the infinity of the same sign and NaN equals nothing,
+// itself included.
+func IsEqualApprox[T Float](x, y T) bool {
+	return IsEqualApproxTol(x, y, CMP_EPSILON)
+}
+
+// IsEqualApproxTol checks if x and y differ by less than the absolute tolerance.
+// Infinities and NaN are handled as in IsEqualApprox.
+func IsEqualApproxTol[T Float](x, y, tolerance T) bool {
+	if x == y {
+		return true
+	}
+	return math.Abs(float64(x-y)) < float64(tolerance)
+}
+
+// IsEqualApproxRelative checks if x and y are approximately equal with a
+// tolerance of CMP_EPSILON scaled by the magnitude of x, and never below
+// CMP_EPSILON. This is the comparison Godot's is_equal_approx uses; it stays
+// meaningful for large values, where a fixed tolerance is below the spacing
+// of representable numbers.
+func IsEqualApproxRelative[T Float](x, y T) bool {
+	tolerance := CMP_EPSILON * math.Abs(float64(x))
+	if tolerance < CMP_EPSILON {
+		tolerance = CMP_EPSILON
+	}
+	return IsEqualApproxTol(x, y, T(tolerance))
+}
+
+// Sign returns 1 if x is positive, -1 if x is negative, and 0 otherwise.
+func Sign[T Float](x T) T {
+	if x > 0 {
+		return 1
+	} else if x < 0 {
+		return -1
+	}
+	return 0
+}
+
+// Clampf clamps val to the range [min, max].
+func Clampf[T Float](val, min, max T) T {
+	if val < min {
+		return min
+	} else if val > max {
+		return max
+	}
+	return val
+}
+
+// Snapped returns the nearest value to from that is a multiple of to,
+// rounding halfway values away from zero. A negative to is treated as its
+// absolute value. If to is zero, from is returned unchanged, as in Godot.
+func Snapped[T Float](from, to T) T {
+	if to == 0 {
+		return from
+	}
+	to = T(math.Abs(float64(to)))
+	return T(math.Round(float64(from/to))) * to
+}
+
+// Fposmod returns the positive floating-point modulus of x modulo y.
+func Fposmod[T Float](x, y T) T {
+	result := T(math.Mod(float64(x), float64(y)))
+	if result < 0 {
+		result += y
+	}
+	return result
+}
+
+// Lerp performs linear interpolation between two values.
+// The conversion keeps the product from being fused into a multiply-add, so
+// float32 results are the same on every architecture.
+func Lerp[T Float](from, to, weight T) T {
+	return from + T((to-from)*weight)
+}
+
+// Adjugate returns the adjugate (transposed cofactor matrix) of a 3x3 matrix
+// together with its determinant, so the inverse is adj / det when det != 0.
+// It is the cofactor code of Godot's Basis::invert.
+// Products are converted before they are added, as in Lerp.
+func Adjugate[T Float](rows [3][3]T) (adj [3][3]T, det T) {
+	cofac := func(row1, col1, row2, col2 int) T {
+		return T(rows[row1][col1]*rows[row2][col2]) - T(rows[row1][col2]*rows[row2][col1])
+	}
+	co := [3]T{
+		cofac(1, 1, 2, 2),
+		cofac(1, 2, 2, 0),
+		cofac(1, 0, 2, 1),
+	}
+	det = T(rows[0][0]*co[0]) + T(rows[0][1]*co[1]) + T(rows[0][2]*co[2])
+	adj = [3][3]T{
+		{co[0], cofac(0, 2, 2, 1), cofac(0, 1, 1, 2)},
+		{co[1], cofac(0, 0, 2, 2), cofac(0, 2, 1, 0)},
+		{co[2], cofac(0, 1, 2, 0), cofac(0, 0, 1, 1)},
+	}
+	return adj, det
+}
@@ -0,0 +1,91 @@
+package core_test
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/internal/core"
+	"github.com/Anaxarchus/zero-gdscript/pkg/mathgd32"
+)
+
+func TestCore_Constants(t *testing.T) {
+	constants := map[string][3]float64{
+		"CMP_EPSILON":                {core.CMP_EPSILON, zerogdscript.CMP_EPSILON, mathgd32.CMP_EPSILON},
+		"CMP_EPSILON2":               {core.CMP_EPSILON2, zerogdscript.CMP_EPSILON2, mathgd32.CMP_EPSILON2},
+		"CMP_NORMALIZE_TOLERANCE":    {core.CMP_NORMALIZE_TOLERANCE, zerogdscript.CMP_NORMALIZE_TOLERANCE, mathgd32.CMP_NORMALIZE_TOLERANCE},
+		"CMP_POINT_IN_PLANE_EPSILON": {core.CMP_POINT_IN_PLANE_EPSILON, zerogdscript.CMP_POINT_IN_PLANE_EPSILON, mathgd32.CMP_POINT_IN_PLANE_EPSILON},
+		"UNIT_EPSILON":               {core.UNIT_EPSILON, zerogdscript.UNIT_EPSILON, mathgd32.UNIT_EPSILON},
+		"TAU":                        {core.TAU, zerogdscript.TAU, mathgd32.TAU},
+		"PI":                         {core.PI, zerogdscript.PI, mathgd32.PI},
+	}
+	for name, v := range constants {
+		if v[0] != v[1] || v[0] != v[2] {
+			t.Errorf("%s: core %v, zerogdscript %v, mathgd32 %v", name, v[0], v[1], v[2])
+		}
+	}
+}
+
+// TestCore_Functions calls each shared helper of both packages through
+// reflection with the same arguments and checks that the results agree. The
+// arguments are exactly representable in float32, so results may only differ
+// by float32 rounding of the arithmetic itself.
+func TestCore_Functions(t *testing.T) {
+	funcs := map[string][2]any{
+		"IsFinitef":             {zerogdscript.IsFinitef, mathgd32.IsFinitef},
+		"IsZeroApprox":          {zerogdscript.IsZeroApprox, mathgd32.IsZeroApprox},
+		"IsEqualApprox":         {zerogdscript.IsEqualApprox, mathgd32.IsEqualApprox},
+		"IsEqualApproxTol":      {zerogdscript.IsEqualApproxTol, mathgd32.IsEqualApproxTol},
+		"IsEqualApproxRelative": {zerogdscript.IsEqualApproxRelative, mathgd32.IsEqualApproxRelative},
+		"Sign":                  {zerogdscript.Sign, mathgd32.Sign},
+		"Clampf":                {zerogdscript.Clampf, mathgd32.Clampf},
+		"Snapped":               {zerogdscript.Snapped, mathgd32.Snapped},
+		"Fposmod":               {zerogdscript.Fposmod, mathgd32.Fposmod},
+		"Lerp":                  {zerogdscript.Lerp, mathgd32.Lerp},
+	}
+	values := []float64{-7.5, -1, -0.25, 0, 0.000003814697265625, 0.125, 1, 3.75, 16}
+
+	for name, fs := range funcs {
+		f64, f32 := reflect.ValueOf(fs[0]), reflect.ValueOf(fs[1])
+		if f64.Type().NumIn() != f32.Type().NumIn() {
+			t.Fatalf("%s: the two packages take a different number of arguments", name)
+		}
+		n := f64.Type().NumIn()
+		args := make([]float64, n)
+		var walk func(i int)
+		walk = func(i int) {
+			if i == n {
+				a64 := make([]reflect.Value, n)
+				a32 := make([]reflect.Value, n)
+				for j, a := range args {
+					a64[j] = reflect.ValueOf(a)
+					a32[j] = reflect.ValueOf(float32(a))
+				}
+				r64, r32 := f64.Call(a64)[0], f32.Call(a32)[0]
+				if !sameResult(r64, r32) {
+					t.Errorf("%s%v: zerogdscript %v, mathgd32 %v", name, args, r64, r32)
+				}
+				return
+			}
+			for _, v := range values {
+				args[i] = v
+				walk(i + 1)
+			}
+		}
+		walk(0)
+	}
+}
+
+// sameResult compares a float64 or bool result with its float32 counterpart,
+// allowing float32 rounding.
+func sameResult(r64, r32 reflect.Value) bool {
+	if r64.Kind() == reflect.Bool {
+		return r64.Bool() == r32.Bool()
+	}
+	a, b := r64.Float(), r32.Float()
+	if math.IsNaN(a) || math.IsNaN(b) {
+		return math.IsNaN(a) && math.IsNaN(b)
+	}
+	return math.Abs(a-b) <= 1e-6*math.Max(1, math.Abs(a))
+}
@@ -36,50 +36,83 @@ func DotN(a, b []float64) float64 {
 	return res
 }
 
+// Abs returns a new slice holding the absolute value of each element.
 func Abs(s []float64) []float64 {
-	res := []float64{}
+	res := make([]float64, len(s))
 	for i := 0; i < len(s); i++ {
-		res = append(res, math.Round(s[i]))
+		res[i] = math.Abs(s[i])
 	}
 	return res
 }
 
+// Sign returns a new slice holding the sign of each element, see zerogdscript.Sign.
 func Sign(s []float64) []float64 {
-	res := []float64{}
+	res := make([]float64, len(s))
 	for i := 0; i < len(s); i++ {
-		res = append(res, math.Round(s[i]))
+		res[i] = zerogdscript.Sign(s[i])
 	}
 	return res
 }
 
+// Floor returns a new slice holding each element rounded down.
 func Floor(s []float64) []float64 {
-	res := []float64{}
+	res := make([]float64, len(s))
 	for i := 0; i < len(s); i++ {
-		res = append(res, math.Round(s[i]))
+		res[i] = math.Floor(s[i])
 	}
 	return res
 }
 
+// Ceil returns a new slice holding each element rounded up.
 func Ceil(s []float64) []float64 {
-	res := []float64{}
+	res := make([]float64, len(s))
 	for i := 0; i < len(s); i++ {
-		res = append(res, math.Round(s[i]))
+		res[i] = math.Ceil(s[i])
 	}
 	return res
 }
 
+// Round returns a new slice holding each element rounded to the nearest integer.
 func Round(s []float64) []float64 {
-	res := []float64{}
+	res := make([]float64, len(s))
 	for i := 0; i < len(s); i++ {
-		res = append(res, math.Round(s[i]))
+		res[i] = math.Round(s[i])
 	}
 	return res
 }
 
+// Lerp returns a new slice interpolating each element of a towards b by weight.
+// Only the common prefix is interpolated; when the lengths differ, the result has
+// the length of the longer slice and the remaining elements are copied from it.
+// Neither input is modified.
 func Lerp(a, b []float64, weight float64) []float64 {
-	res := a
-	for i := 0; i < min(len(a), len(b)); i++ {
-		res[i] = zerogdscript.Lerp(b[i], a[i], weight)
+	n := min(len(a), len(b))
+	res := make([]float64, max(len(a), len(b)))
+	for i := 0; i < n; i++ {
+		res[i] = zerogdscript.Lerp(a[i], b[i], weight)
+	}
+	if len(a) > n {
+		copy(res[n:], a[n:])
+	} else {
+		copy(res[n:], b[n:])
 	}
 	return res
 }
+
+// KahanSum returns the sum of values using compensated (Kahan-Babuska)
+// summation. The rounding error of each addition is carried into the next, so
+// the result stays accurate for long slices where a plain loop drifts, and
+// also when a large term cancels against another.
+func KahanSum(values []float64) float64 {
+	sum, c := 0.0, 0.0
+	for _, v := range values {
+		t := sum + v
+		if math.Abs(sum) >= math.Abs(v) {
+			c += (sum - t) + v
+		} else {
+			c += (v - t) + sum
+		}
+		sum = t
+	}
+	return sum + c
+}
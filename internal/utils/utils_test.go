@@ -0,0 +1,123 @@
+package utils
+
+import (
+	"math"
+	"testing"
+)
+
+func equalSlices(a, b []float64) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+func TestUtils_Abs(t *testing.T) {
+	got := Abs([]float64{-2, -3.7, 0, 2.9, 4})
+	want := []float64{2, 3.7, 0, 2.9, 4}
+	if !equalSlices(got, want) {
+		t.Errorf("Abs() = %v, want %v", got, want)
+	}
+}
+
+func TestUtils_Sign(t *testing.T) {
+	got := Sign([]float64{-3.7, -0.2, 0, 0.2, 2.9})
+	want := []float64{-1, -1, 0, 1, 1}
+	if !equalSlices(got, want) {
+		t.Errorf("Sign() = %v, want %v", got, want)
+	}
+}
+
+func TestUtils_Floor(t *testing.T) {
+	got := Floor([]float64{-3.7, -2, 0, 2.9, 4})
+	want := []float64{-4, -2, 0, 2, 4}
+	if !equalSlices(got, want) {
+		t.Errorf("Floor() = %v, want %v", got, want)
+	}
+}
+
+func TestUtils_Ceil(t *testing.T) {
+	got := Ceil([]float64{-3.7, -2, 0, 2.1, 4})
+	want := []float64{-3, -2, 0, 3, 4}
+	if !equalSlices(got, want) {
+		t.Errorf("Ceil() = %v, want %v", got, want)
+	}
+}
+
+func TestUtils_Round(t *testing.T) {
+	got := Round([]float64{-3.7, -2.2, 0, 2.5, 2.4})
+	want := []float64{-4, -2, 0, 3, 2}
+	if !equalSlices(got, want) {
+		t.Errorf("Round() = %v, want %v", got, want)
+	}
+}
+
+func TestUtils_Empty(t *testing.T) {
+	for name, f := range map[string]func([]float64) []float64{
+		"Abs": Abs, "Sign": Sign, "Floor": Floor, "Ceil": Ceil, "Round": Round,
+	} {
+		if got := f(nil); got == nil || len(got) != 0 {
+			t.Errorf("%s(nil) = %#v, want an empty slice", name, got)
+		}
+	}
+}
+
+func TestUtils_Lerp(t *testing.T) {
+	a := []float64{0, 10, -4}
+	b := []float64{10, 20, 4}
+
+	if got, want := Lerp(a, b, 0), a; !equalSlices(got, want) {
+		t.Errorf("Lerp(a, b, 0) = %v, want %v", got, want)
+	}
+	if got, want := Lerp(a, b, 1), b; !equalSlices(got, want) {
+		t.Errorf("Lerp(a, b, 1) = %v, want %v", got, want)
+	}
+	if got, want := Lerp(a, b, 0.25), []float64{2.5, 12.5, -2}; !equalSlices(got, want) {
+		t.Errorf("Lerp(a, b, 0.25) = %v, want %v", got, want)
+	}
+	if !equalSlices(a, []float64{0, 10, -4}) || !equalSlices(b, []float64{10, 20, 4}) {
+		t.Errorf("Lerp() modified its inputs: a = %v, b = %v", a, b)
+	}
+
+	short := []float64{2}
+	long := []float64{4, 7, 9}
+	if got, want := Lerp(short, long, 0.5), []float64{3, 7, 9}; !equalSlices(got, want) {
+		t.Errorf("Lerp(short, long, 0.5) = %v, want %v", got, want)
+	}
+	if got, want := Lerp(long, short, 0.5), []float64{3, 7, 9}; !equalSlices(got, want) {
+		t.Errorf("Lerp(long, short, 0.5) = %v, want %v", got, want)
+	}
+	if !equalSlices(long, []float64{4, 7, 9}) {
+		t.Errorf("Lerp() modified the longer input: %v", long)
+	}
+}
+
+func TestUtils_KahanSum(t *testing.T) {
+	values := make([]float64, 1_000_000)
+	for i := range values {
+		values[i] = 0.1
+	}
+	naive := 0.0
+	for _, v := range values {
+		naive += v
+	}
+	// A plain loop is visibly off after a million additions of 0.1.
+	if math.Abs(naive-100000) < 1e-7 {
+		t.Fatalf("naive sum = %v, expected it to drift from 100000", naive)
+	}
+	if got := KahanSum(values); math.Abs(got-100000) > 1e-9 {
+		t.Errorf("KahanSum(1e6 x 0.1) = %v, want 100000", got)
+	}
+
+	if got := KahanSum([]float64{1, 1e100, 1, -1e100}); got != 2 {
+		t.Errorf("KahanSum(1, 1e100, 1, -1e100) = %v, want 2", got)
+	}
+	if got := KahanSum(nil); got != 0 {
+		t.Errorf("KahanSum(nil) = %v, want 0", got)
+	}
+}
@@ -0,0 +1,31 @@
+package zerogdscript
+
+import "github.com/Anaxarchus/zero-gdscript/internal/core"
+
+// SolveLinear2x2 solves a·x = b for x, where a is given as rows. It returns
+// ErrSingular if the determinant of a is zero.
+func SolveLinear2x2(a [2][2]float64, b [2]float64) ([2]float64, error) {
+	det := a[0][0]*a[1][1] - a[0][1]*a[1][0]
+	if det == 0 {
+		return [2]float64{}, ErrSingular
+	}
+	return [2]float64{
+		(b[0]*a[1][1] - a[0][1]*b[1]) / det,
+		(a[0][0]*b[1] - b[0]*a[1][0]) / det,
+	}, nil
+}
+
+// SolveLinear3x3 solves a·x = b for x, where a is given as rows, using the
+// same cofactors as Basis.Invert. It returns ErrSingular if the determinant
+// of a is zero.
+func SolveLinear3x3(a [3][3]float64, b [3]float64) ([3]float64, error) {
+	adj, det := core.Adjugate(a)
+	if det == 0 {
+		return [3]float64{}, ErrSingular
+	}
+	var x [3]float64
+	for i := range x {
+		x[i] = (adj[i][0]*b[0] + adj[i][1]*b[1] + adj[i][2]*b[2]) / det
+	}
+	return x, nil
+}
@@ -0,0 +1,60 @@
+package zerogdscript
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestMathgd_SolveLinear2x2(t *testing.T) {
+	tests := []struct {
+		a       [2][2]float64
+		b       [2]float64
+		want    [2]float64
+		wantErr error
+	}{
+		{[2][2]float64{{1, 0}, {0, 1}}, [2]float64{3, -4}, [2]float64{3, -4}, nil},
+		// 2x + y = 5, x - 3y = -8
+		{[2][2]float64{{2, 1}, {1, -3}}, [2]float64{5, -8}, [2]float64{1, 3}, nil},
+		{[2][2]float64{{0, 2}, {3, 0}}, [2]float64{4, 9}, [2]float64{3, 2}, nil},
+		{[2][2]float64{{1, 2}, {2, 4}}, [2]float64{1, 2}, [2]float64{}, ErrSingular},
+		{[2][2]float64{}, [2]float64{1, 1}, [2]float64{}, ErrSingular},
+	}
+	for _, tt := range tests {
+		got, err := SolveLinear2x2(tt.a, tt.b)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("SolveLinear2x2(%v, %v) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+		}
+		if !IsEqualApprox(got[0], tt.want[0]) || !IsEqualApprox(got[1], tt.want[1]) {
+			t.Errorf("SolveLinear2x2(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_SolveLinear3x3(t *testing.T) {
+	tests := []struct {
+		a       [3][3]float64
+		b       [3]float64
+		want    [3]float64
+		wantErr error
+	}{
+		{[3][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}}, [3]float64{1, 2, 3}, [3]float64{1, 2, 3}, nil},
+		// 2x + y - z = 8, -3x - y + 2z = -11, -2x + y + 2z = -3
+		{[3][3]float64{{2, 1, -1}, {-3, -1, 2}, {-2, 1, 2}}, [3]float64{8, -11, -3}, [3]float64{2, 3, -1}, nil},
+		// A zero in the top-left corner needs no pivoting.
+		{[3][3]float64{{0, 1, 1}, {1, 0, 1}, {1, 1, 0}}, [3]float64{5, 4, 3}, [3]float64{1, 2, 3}, nil},
+		{[3][3]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}, [3]float64{1, 2, 3}, [3]float64{}, ErrSingular},
+		{[3][3]float64{{1, 2, 3}, {0, 0, 0}, {7, 8, 9}}, [3]float64{1, 0, 3}, [3]float64{}, ErrSingular},
+	}
+	for _, tt := range tests {
+		got, err := SolveLinear3x3(tt.a, tt.b)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("SolveLinear3x3(%v, %v) error = %v, want %v", tt.a, tt.b, err, tt.wantErr)
+		}
+		for i := range got {
+			if !IsEqualApprox(got[i], tt.want[i]) {
+				t.Errorf("SolveLinear3x3(%v, %v) = %v, want %v", tt.a, tt.b, got, tt.want)
+				break
+			}
+		}
+	}
+}
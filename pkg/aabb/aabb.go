@@ -0,0 +1,193 @@
+package aabb
+
+/**************************************************************************/
+/*  aabb.h                                                                */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/plane"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// AABB is a 3D axis-aligned bounding box, stored as its minimum corner and
+// its size. The size is expected to be non-negative.
+type AABB struct {
+	Position vector3.Vector3 `json:"position"`
+	Size     vector3.Vector3 `json:"size"`
+}
+
+// New returns the box with the given minimum corner and size.
+func New(position, size vector3.Vector3) AABB {
+	return AABB{Position: position, Size: size}
+}
+
+// FromPoints returns the smallest box containing every point. An empty slice
+// returns the zero box.
+func FromPoints(points []vector3.Vector3) AABB {
+	if len(points) == 0 {
+		return AABB{}
+	}
+	min, max := points[0], points[0]
+	for _, p := range points[1:] {
+		min = min.Min(p)
+		max = max.Max(p)
+	}
+	return AABB{Position: min, Size: max.Sub(min)}
+}
+
+// Expand returns the smallest box containing both a and point, like Godot's
+// AABB.expand. Starting from a box of zero size at the first point and
+// expanding by the rest gives the same box as FromPoints.
+func (a AABB) Expand(point vector3.Vector3) AABB {
+	min := a.Position.Min(point)
+	max := a.GetEnd().Max(point)
+	return AABB{Position: min, Size: max.Sub(min)}
+}
+
+// Merge returns the smallest box containing both a and b.
+func (a AABB) Merge(b AABB) AABB {
+	min := a.Position.Min(b.Position)
+	max := a.GetEnd().Max(b.GetEnd())
+	return AABB{Position: min, Size: max.Sub(min)}
+}
+
+// Intersects reports whether a and b overlap. As in Godot, boxes that only
+// touch along a face, edge or corner do not intersect.
+func (a AABB) Intersects(b AABB) bool {
+	aEnd, bEnd := a.GetEnd(), b.GetEnd()
+	return a.Position.X < bEnd.X && b.Position.X < aEnd.X &&
+		a.Position.Y < bEnd.Y && b.Position.Y < aEnd.Y &&
+		a.Position.Z < bEnd.Z && b.Position.Z < aEnd.Z
+}
+
+// IntersectsRay returns where the ray from from along dir first enters the
+// box, using the slab method of Godot's AABB.intersects_ray. A ray starting
+// inside the box hits at from. dir need not be normalized; components of
+// zero are handled without dividing by them.
+func (a AABB) IntersectsRay(from, dir vector3.Vector3) (vector3.Vector3, bool) {
+	begin, end := a.Position.AsArray(), a.GetEnd().AsArray()
+	f, d := from.AsArray(), dir.AsArray()
+	tmin, tmax := -1e20, 1e20
+	for i := 0; i < 3; i++ {
+		if d[i] == 0 {
+			if f[i] < begin[i] || f[i] > end[i] {
+				return vector3.Vector3{}, false
+			}
+			continue
+		}
+		t1 := (begin[i] - f[i]) / d[i]
+		t2 := (end[i] - f[i]) / d[i]
+		if t1 > t2 {
+			t1, t2 = t2, t1
+		}
+		if t1 >= tmin {
+			tmin = t1
+		}
+		if t2 < tmax {
+			if t2 < 0 {
+				return vector3.Vector3{}, false
+			}
+			tmax = t2
+		}
+		if tmin > tmax {
+			return vector3.Vector3{}, false
+		}
+	}
+	if tmin < 0 {
+		return from, true
+	}
+	return from.Add(dir.Mulf(tmin)), true
+}
+
+// GetEnd returns the maximum corner, Position + Size.
+func (a AABB) GetEnd() vector3.Vector3 {
+	return a.Position.Add(a.Size)
+}
+
+// GetCenter returns the centre of the box.
+func (a AABB) GetCenter() vector3.Vector3 {
+	return a.Position.Add(a.Size.Mulf(0.5))
+}
+
+// GetEndpoint returns one of the eight corners, in Godot's order: bit 2 of
+// idx selects the X end, bit 1 the Y end and bit 0 the Z end. Indices out of
+// range return the minimum corner.
+func (a AABB) GetEndpoint(idx int) vector3.Vector3 {
+	p := a.Position
+	if idx < 0 || idx > 7 {
+		return p
+	}
+	if idx&4 != 0 {
+		p.X += a.Size.X
+	}
+	if idx&2 != 0 {
+		p.Y += a.Size.Y
+	}
+	if idx&1 != 0 {
+		p.Z += a.Size.Z
+	}
+	return p
+}
+
+// IntersectsConvexShape reports whether the box may intersect the convex
+// volume bounded by planes, whose normals point outwards. The box is
+// rejected only when it lies entirely over one of the planes, so a box near
+// an edge of the volume may be reported as intersecting when it is not. This
+// is the usual trade-off for frustum culling.
+func (a AABB) IntersectsConvexShape(planes []plane.Plane) bool {
+	half := a.Size.Mulf(0.5)
+	center := a.Position.Add(half)
+	for _, p := range planes {
+		// The corner furthest behind the plane.
+		corner := center
+		if p.Normal.X > 0 {
+			corner.X -= half.X
+		} else {
+			corner.X += half.X
+		}
+		if p.Normal.Y > 0 {
+			corner.Y -= half.Y
+		} else {
+			corner.Y += half.Y
+		}
+		if p.Normal.Z > 0 {
+			corner.Z -= half.Z
+		} else {
+			corner.Z += half.Z
+		}
+		if p.DistanceTo(corner) > 0 {
+			return false
+		}
+	}
+	return true
+}
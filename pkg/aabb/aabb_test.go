@@ -0,0 +1,136 @@
+package aabb
+
+import (
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/plane"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+func TestAABB_GetEndpoint(t *testing.T) {
+	a := New(vector3.New(1, 2, 3), vector3.New(10, 20, 30))
+	want := []vector3.Vector3{
+		vector3.New(1, 2, 3), vector3.New(1, 2, 33), vector3.New(1, 22, 3), vector3.New(1, 22, 33),
+		vector3.New(11, 2, 3), vector3.New(11, 2, 33), vector3.New(11, 22, 3), vector3.New(11, 22, 33),
+	}
+	for i, w := range want {
+		if got := a.GetEndpoint(i); got != w {
+			t.Errorf("GetEndpoint(%d) = %v, want %v", i, got, w)
+		}
+	}
+	if got := a.GetEndpoint(8); got != a.Position {
+		t.Errorf("GetEndpoint(8) = %v, want the position", got)
+	}
+	if got := a.GetEnd(); got != want[7] {
+		t.Errorf("GetEnd() = %v, want %v", got, want[7])
+	}
+	if got := a.GetCenter(); got != vector3.New(6, 12, 18) {
+		t.Errorf("GetCenter() = %v, want (6, 12, 18)", got)
+	}
+}
+
+func TestAABB_FromPoints(t *testing.T) {
+	points := []vector3.Vector3{
+		vector3.New(1, 2, 3), vector3.New(-4, 0.5, 6), vector3.New(2, -3, -1),
+		vector3.New(0, 0, 0), vector3.New(1.5, 7, 2),
+	}
+	want := New(vector3.New(-4, -3, -1), vector3.New(6, 10, 7))
+	if got := FromPoints(points); got != want {
+		t.Errorf("FromPoints() = %v, want %v", got, want)
+	}
+
+	// Built point by point, as while loading a mesh.
+	box := New(points[0], vector3.Zero())
+	for _, p := range points[1:] {
+		box = box.Expand(p)
+	}
+	if box != want {
+		t.Errorf("expanded box = %v, want FromPoints() = %v", box, want)
+	}
+
+	// A point already inside leaves the box alone.
+	if got := want.Expand(vector3.New(0, 0, 0)); got != want {
+		t.Errorf("Expand(inside) = %v, want %v", got, want)
+	}
+	if got, want := FromPoints(points[:1]), New(points[0], vector3.Zero()); got != want {
+		t.Errorf("FromPoints(one point) = %v, want %v", got, want)
+	}
+	if got := FromPoints(nil); got != (AABB{}) {
+		t.Errorf("FromPoints(nil) = %v, want the zero box", got)
+	}
+}
+
+func TestAABB_Intersects(t *testing.T) {
+	a := New(vector3.New(0, 0, 0), vector3.New(2, 2, 2))
+	for _, tt := range []struct {
+		b    AABB
+		want bool
+	}{
+		{New(vector3.New(1, 1, 1), vector3.New(2, 2, 2)), true},
+		{New(vector3.New(0.5, 0.5, 0.5), vector3.New(1, 1, 1)), true},
+		{New(vector3.New(-1, -1, -1), vector3.New(4, 4, 4)), true},
+		{New(vector3.New(2, 0, 0), vector3.New(1, 1, 1)), false}, // touching face
+		{New(vector3.New(3, 0, 0), vector3.New(1, 1, 1)), false},
+		{New(vector3.New(0, 0, -5), vector3.New(1, 1, 1)), false},
+	} {
+		if got := a.Intersects(tt.b); got != tt.want {
+			t.Errorf("Intersects(%v) = %v, want %v", tt.b, got, tt.want)
+		}
+		if got := tt.b.Intersects(a); got != tt.want {
+			t.Errorf("%v.Intersects(a) = %v, want %v", tt.b, got, tt.want)
+		}
+	}
+	if got, want := a.Merge(New(vector3.New(3, -1, 1), vector3.New(1, 1, 1))), New(vector3.New(0, -1, 0), vector3.New(4, 3, 2)); got != want {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+}
+
+func TestAABB_IntersectsRay(t *testing.T) {
+	box := New(vector3.New(1, 1, 1), vector3.New(2, 2, 2))
+	for _, tt := range []struct {
+		name      string
+		from, dir vector3.Vector3
+		hit       bool
+		point     vector3.Vector3
+	}{
+		{"along x", vector3.New(-5, 2, 2), vector3.New(1, 0, 0), true, vector3.New(1, 2, 2)},
+		{"unnormalized", vector3.New(-5, 2, 2), vector3.New(3, 0, 0), true, vector3.New(1, 2, 2)},
+		{"diagonal", vector3.New(0, 0, 0), vector3.New(1, 1, 1), true, vector3.New(1, 1, 1)},
+		{"from inside", vector3.New(2, 2, 2), vector3.New(0, -1, 0), true, vector3.New(2, 2, 2)},
+		{"pointing away", vector3.New(-5, 2, 2), vector3.New(-1, 0, 0), false, vector3.Vector3{}},
+		{"passing beside", vector3.New(-5, 5, 2), vector3.New(1, 0, 0), false, vector3.Vector3{}},
+		{"parallel outside", vector3.New(0, 0, 5), vector3.New(0, 1, 0), false, vector3.Vector3{}},
+		{"missing diagonally", vector3.New(0, 4, 0), vector3.New(1, 0, 1), false, vector3.Vector3{}},
+		{"behind the origin", vector3.New(5, 2, 2), vector3.New(1, 0, 0), false, vector3.Vector3{}},
+	} {
+		point, hit := box.IntersectsRay(tt.from, tt.dir)
+		if hit != tt.hit || (hit && !point.IsEqualApprox(tt.point)) {
+			t.Errorf("%s: IntersectsRay = %v, %v, want %v, %v", tt.name, point, hit, tt.point, tt.hit)
+		}
+	}
+}
+
+func TestAABB_IntersectsConvexShape(t *testing.T) {
+	// The unit cube [0, 1]^3 as outward-facing planes.
+	cube := []plane.Plane{
+		plane.New(vector3.New(-1, 0, 0), 0), plane.New(vector3.New(1, 0, 0), 1),
+		plane.New(vector3.New(0, -1, 0), 0), plane.New(vector3.New(0, 1, 0), 1),
+		plane.New(vector3.New(0, 0, -1), 0), plane.New(vector3.New(0, 0, 1), 1),
+	}
+	for _, tt := range []struct {
+		name string
+		box  AABB
+		want bool
+	}{
+		{"inside", New(vector3.New(0.25, 0.25, 0.25), vector3.New(0.5, 0.5, 0.5)), true},
+		{"enclosing", New(vector3.New(-1, -1, -1), vector3.New(3, 3, 3)), true},
+		{"straddling", New(vector3.New(0.5, -0.5, 0.5), vector3.New(1, 1, 1)), true},
+		{"touching", New(vector3.New(1, 0, 0), vector3.New(1, 1, 1)), true},
+		{"outside", New(vector3.New(1.5, 0, 0), vector3.New(1, 1, 1)), false},
+		{"below", New(vector3.New(0, -3, 0), vector3.New(1, 1, 1)), false},
+	} {
+		if got := tt.box.IntersectsConvexShape(cube); got != tt.want {
+			t.Errorf("%s: IntersectsConvexShape = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
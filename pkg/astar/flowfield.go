@@ -0,0 +1,110 @@
+package astar
+
+import (
+	"container/heap"
+	"math"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2i"
+)
+
+var (
+	orthogonalSteps = [...]vector2i.Vector2i{{X: 1}, {X: -1}, {Y: 1}, {Y: -1}}
+	diagonalSteps   = [...]vector2i.Vector2i{{X: 1, Y: 1}, {X: 1, Y: -1}, {X: -1, Y: 1}, {X: -1, Y: -1}}
+)
+
+// FlowField returns, for every cell, the unit direction toward the next cell
+// on a shortest path to goal, indexed as field[y][x]. It runs one Dijkstra
+// sweep outward from goal, so any number of agents can then follow the field
+// at the cost of a lookup. Orthogonal steps cost 1 and diagonal steps, when
+// the grid allows them, cost Sqrt2. The goal, solid cells and cells that
+// cannot reach the goal get a zero vector, as does every cell when goal is
+// solid or outside the grid.
+func FlowField(grid *Grid, goal vector2i.Vector2i) [][]vector2.Vector2 {
+	w, h := grid.size.X, grid.size.Y
+	field := make([][]vector2.Vector2, h)
+	for y := range field {
+		field[y] = make([]vector2.Vector2, w)
+	}
+	if grid.IsPointSolid(goal) {
+		return field
+	}
+
+	dist := make([]float64, w*h)
+	for i := range dist {
+		dist[i] = math.Inf(1)
+	}
+	index := func(id vector2i.Vector2i) int { return id.Y*w + id.X }
+	dist[index(goal)] = 0
+	queue := &cellQueue{{goal, 0}}
+	for queue.Len() > 0 {
+		c := heap.Pop(queue).(cellCost)
+		if c.cost > dist[index(c.id)] {
+			continue
+		}
+		grid.forEachNeighbour(c.id, func(next vector2i.Vector2i, step float64) {
+			if cost := c.cost + step; cost < dist[index(next)] {
+				dist[index(next)] = cost
+				heap.Push(queue, cellCost{next, cost})
+			}
+		})
+	}
+
+	// Point every reachable cell at its cheapest neighbour. Moves are
+	// symmetric, so that neighbour is the next cell on a shortest path.
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			id := vector2i.New(x, y)
+			if id == goal || math.IsInf(dist[index(id)], 1) {
+				continue
+			}
+			best, bestCost := id, math.Inf(1)
+			grid.forEachNeighbour(id, func(next vector2i.Vector2i, step float64) {
+				if cost := dist[index(next)] + step; cost < bestCost {
+					best, bestCost = next, cost
+				}
+			})
+			field[y][x] = best.Sub(id).ToVector2().Normalized()
+		}
+	}
+	return field
+}
+
+// forEachNeighbour calls f for every free cell reachable from id in one step,
+// with the cost of that step.
+func (g *Grid) forEachNeighbour(id vector2i.Vector2i, f func(next vector2i.Vector2i, step float64)) {
+	for _, s := range orthogonalSteps {
+		if next := id.Add(s); !g.IsPointSolid(next) {
+			f(next, 1)
+		}
+	}
+	if !g.Diagonal {
+		return
+	}
+	for _, s := range diagonalSteps {
+		next := id.Add(s)
+		if g.IsPointSolid(next) || g.IsPointSolid(vector2i.New(next.X, id.Y)) || g.IsPointSolid(vector2i.New(id.X, next.Y)) {
+			continue
+		}
+		f(next, math.Sqrt2)
+	}
+}
+
+type cellCost struct {
+	id   vector2i.Vector2i
+	cost float64
+}
+
+// cellQueue is a min-heap of cells by cost.
+type cellQueue []cellCost
+
+func (q cellQueue) Len() int           { return len(q) }
+func (q cellQueue) Less(i, j int) bool { return q[i].cost < q[j].cost }
+func (q cellQueue) Swap(i, j int)      { q[i], q[j] = q[j], q[i] }
+func (q *cellQueue) Push(x any)        { *q = append(*q, x.(cellCost)) }
+func (q *cellQueue) Pop() any {
+	old := *q
+	c := old[len(old)-1]
+	*q = old[:len(old)-1]
+	return c
+}
@@ -0,0 +1,107 @@
+package astar
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2i"
+)
+
+// follow walks the field from start and returns the visited cells, stopping
+// at the goal, on a zero vector or after limit steps.
+func follow(t *testing.T, g *Grid, field [][]vector2.Vector2, start vector2i.Vector2i, limit int) []vector2i.Vector2i {
+	t.Helper()
+	path := []vector2i.Vector2i{start}
+	cur := start
+	for i := 0; i < limit; i++ {
+		d := field[cur.Y][cur.X]
+		if d == vector2.Zero() {
+			break
+		}
+		if !d.IsNormalized() {
+			t.Fatalf("field at %v = %v is not a unit vector", cur, d)
+		}
+		cur = cur.Add(vector2i.New(int(math.Round(d.X)), int(math.Round(d.Y))))
+		if g.IsPointSolid(cur) {
+			t.Fatalf("field leads into solid cell %v", cur)
+		}
+		path = append(path, cur)
+	}
+	return path
+}
+
+func TestAStar_FlowField(t *testing.T) {
+	// A wall at x = 2 with a gap in the bottom row:
+	//   . . # . G
+	//   . . # . .
+	//   . . # . .
+	//   . . # . .
+	//   . . . . .
+	g := NewGrid(5, 5)
+	for y := 0; y < 4; y++ {
+		g.SetPointSolid(vector2i.New(2, y), true)
+	}
+	goal := vector2i.New(4, 0)
+	field := FlowField(g, goal)
+
+	if len(field) != 5 || len(field[0]) != 5 {
+		t.Fatalf("field is %dx%d, want 5x5", len(field[0]), len(field))
+	}
+	if field[goal.Y][goal.X] != vector2.Zero() || field[0][2] != vector2.Zero() {
+		t.Errorf("goal and solid cells should have zero vectors")
+	}
+	// Next to the wall the field points down toward the gap, not into the wall.
+	if got := field[0][1]; got != vector2.New(0, 1) {
+		t.Errorf("field at (1, 0) = %v, want (0, 1)", got)
+	}
+	path := follow(t, g, field, vector2i.New(0, 0), 100)
+	if path[len(path)-1] != goal {
+		t.Fatalf("following the field from (0, 0) ended at %v", path[len(path)-1])
+	}
+	if steps := len(path) - 1; steps != 12 {
+		t.Errorf("path around the wall took %d steps, want 12: %v", steps, path)
+	}
+
+	// With diagonals the path shortens but still never cuts the wall's corner.
+	g.Diagonal = true
+	field = FlowField(g, goal)
+	path = follow(t, g, field, vector2i.New(0, 0), 100)
+	if path[len(path)-1] != goal {
+		t.Fatalf("diagonal: following the field from (0, 0) ended at %v", path[len(path)-1])
+	}
+	for i := 1; i < len(path); i++ {
+		a, b := path[i-1], path[i]
+		if g.IsPointSolid(vector2i.New(b.X, a.Y)) || g.IsPointSolid(vector2i.New(a.X, b.Y)) {
+			t.Errorf("diagonal step %v -> %v cuts a corner", a, b)
+		}
+	}
+	if steps := len(path) - 1; steps >= 12 {
+		t.Errorf("diagonal path took %d steps, want fewer than 12", steps)
+	}
+}
+
+func TestAStar_FlowFieldUnreachable(t *testing.T) {
+	g := NewGrid(3, 3)
+	// Wall off the top-left cell.
+	g.SetPointSolid(vector2i.New(1, 0), true)
+	g.SetPointSolid(vector2i.New(0, 1), true)
+	g.SetPointSolid(vector2i.New(1, 1), true)
+	field := FlowField(g, vector2i.New(2, 2))
+	if field[0][0] != vector2.Zero() {
+		t.Errorf("unreachable cell has direction %v, want zero", field[0][0])
+	}
+	if field[2][1] != vector2.New(1, 0) {
+		t.Errorf("field at (1, 2) = %v, want (1, 0)", field[2][1])
+	}
+
+	for _, goal := range []vector2i.Vector2i{vector2i.New(1, 1), vector2i.New(7, 0)} {
+		for y, row := range FlowField(g, goal) {
+			for x, d := range row {
+				if d != vector2.Zero() {
+					t.Errorf("goal %v: field at (%d, %d) = %v, want zero", goal, x, y, d)
+				}
+			}
+		}
+	}
+}
@@ -0,0 +1,55 @@
+// Package astar provides grid-based pathfinding helpers.
+package astar
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2i"
+)
+
+// Grid is a rectangular grid of cells that are either free or solid, in the
+// spirit of Godot's AStarGrid2D. Cells are addressed by their coordinates,
+// from (0, 0) to Size minus one.
+type Grid struct {
+	size  vector2i.Vector2i
+	solid []bool
+
+	// Diagonal allows moves between diagonal neighbours when both cells that
+	// share an edge with the move are free, so paths never cut corners.
+	Diagonal bool
+}
+
+// NewGrid returns a grid of width by height free cells. Negative sizes are
+// treated as 0.
+func NewGrid(width, height int) *Grid {
+	width, height = max(width, 0), max(height, 0)
+	return &Grid{
+		size:  vector2i.New(width, height),
+		solid: make([]bool, width*height),
+	}
+}
+
+// Size returns the number of cells along each axis.
+func (g *Grid) Size() vector2i.Vector2i {
+	return g.size
+}
+
+// IsInBounds reports whether the cell lies inside the grid.
+func (g *Grid) IsInBounds(id vector2i.Vector2i) bool {
+	return id.X >= 0 && id.Y >= 0 && id.X < g.size.X && id.Y < g.size.Y
+}
+
+// SetPointSolid marks a cell as solid or free. Cells outside the grid are
+// ignored.
+func (g *Grid) SetPointSolid(id vector2i.Vector2i, solid bool) {
+	if g.IsInBounds(id) {
+		g.solid[id.Y*g.size.X+id.X] = solid
+	}
+}
+
+// IsPointSolid reports whether a cell is solid. Cells outside the grid count
+// as solid.
+func (g *Grid) IsPointSolid(id vector2i.Vector2i) bool {
+	if !g.IsInBounds(id) {
+		return true
+	}
+	return g.solid[id.Y*g.size.X+id.X]
+}
@@ -0,0 +1,27 @@
+package astar
+
+import (
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2i"
+)
+
+func TestGrid_SetPointSolid(t *testing.T) {
+	g := NewGrid(3, 2)
+	if g.Size() != vector2i.New(3, 2) {
+		t.Errorf("Size() = %v, want (3, 2)", g.Size())
+	}
+	g.SetPointSolid(vector2i.New(2, 1), true)
+	g.SetPointSolid(vector2i.New(5, 5), true)
+	if !g.IsPointSolid(vector2i.New(2, 1)) || g.IsPointSolid(vector2i.New(1, 1)) {
+		t.Errorf("IsPointSolid does not match SetPointSolid")
+	}
+	for _, id := range []vector2i.Vector2i{{X: -1}, {Y: -1}, {X: 3}, {Y: 2}} {
+		if g.IsInBounds(id) || !g.IsPointSolid(id) {
+			t.Errorf("%v should be out of bounds and solid", id)
+		}
+	}
+	if g := NewGrid(-2, 4); g.Size() != vector2i.New(0, 4) {
+		t.Errorf("NewGrid(-2, 4).Size() = %v, want (0, 4)", g.Size())
+	}
+}
@@ -1,9 +1,11 @@
 package basis
 
 import (
-	"errors"
+	"fmt"
 	"math"
 
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/internal/core"
 	"github.com/Anaxarchus/zero-gdscript/internal/utils"
 )
 
@@ -70,12 +72,222 @@ func New() Basis {
 	}
 }
 
+// FromAxisAndAngle returns the rotation around axis by angle.
+// A non-normalized axis is normalized first; a zero axis returns the identity.
 func FromAxisAndAngle(axis [3]float64, angle float64) Basis {
 	basis := New()
 	basis.SetAxisAngle(axis, angle)
 	return basis
 }
 
+// FromAxisAndAngleChecked is like FromAxisAndAngle but returns ErrZeroLength for a zero axis.
+func FromAxisAndAngleChecked(axis [3]float64, angle float64) (Basis, error) {
+	if _, err := unitAxis(axis); err != nil {
+		return New(), err
+	}
+	return FromAxisAndAngle(axis, angle), nil
+}
+
+// FromEuler returns the rotation for Euler angles (x, y, z) in radians,
+// applied in the given order. An unknown order returns the identity.
+func FromEuler(euler [3]float64, order zerogdscript.EulerOrder) Basis {
+	b := New()
+	b.SetEuler(euler, order)
+	return b
+}
+
+// SetEuler sets the basis to the rotation for Euler angles (x, y, z) in
+// radians, applied in the given order. An unknown order leaves b unchanged.
+func (b *Basis) SetEuler(euler [3]float64, order zerogdscript.EulerOrder) {
+	var xmat, ymat, zmat Basis
+	c, s := math.Cos(euler[0]), math.Sin(euler[0])
+	xmat.Set(1, 0, 0, 0, c, -s, 0, s, c)
+	c, s = math.Cos(euler[1]), math.Sin(euler[1])
+	ymat.Set(c, 0, s, 0, 1, 0, -s, 0, c)
+	c, s = math.Cos(euler[2]), math.Sin(euler[2])
+	zmat.Set(c, -s, 0, s, c, 0, 0, 0, 1)
+
+	switch order {
+	case zerogdscript.EulerOrderXYZ:
+		*b = xmat.Mul(ymat.Mul(zmat))
+	case zerogdscript.EulerOrderXZY:
+		*b = xmat.Mul(zmat).Mul(ymat)
+	case zerogdscript.EulerOrderYXZ:
+		*b = ymat.Mul(xmat).Mul(zmat)
+	case zerogdscript.EulerOrderYZX:
+		*b = ymat.Mul(zmat).Mul(xmat)
+	case zerogdscript.EulerOrderZXY:
+		*b = zmat.Mul(xmat).Mul(ymat)
+	case zerogdscript.EulerOrderZYX:
+		*b = zmat.Mul(ymat).Mul(xmat)
+	}
+}
+
+// GetEuler returns the Euler angles (x, y, z) in radians that rebuild the
+// basis when applied in the given order. The basis is expected to be a pure
+// rotation. At gimbal lock, when the middle rotation is +-PI/2, the first
+// and last axes coincide and the whole remaining angle is put on one of
+// them. An unknown order returns zero angles.
+func (b Basis) GetEuler(order zerogdscript.EulerOrder) [3]float64 {
+	const lock = 1.0 - zerogdscript.CMP_EPSILON
+	rows := b.Rows
+	var euler [3]float64
+	switch order {
+	case zerogdscript.EulerOrderXYZ:
+		// rot =  cy*cz          -cy*sz           sy
+		//        cz*sx*sy+cx*sz  cx*cz-sx*sy*sz -cy*sx
+		//       -cx*cz*sy+sx*sz  cz*sx+cx*sy*sz  cx*cy
+		sy := rows[0][2]
+		if sy < lock {
+			if sy > -lock {
+				// is this a pure Y rotation?
+				if rows[1][0] == 0 && rows[0][1] == 0 && rows[1][2] == 0 && rows[2][1] == 0 && rows[1][1] == 1 {
+					// return the simplest form (human friendlier in editor and scripts)
+					euler = [3]float64{0, math.Atan2(rows[0][2], rows[0][0]), 0}
+				} else {
+					euler = [3]float64{math.Atan2(-rows[1][2], rows[2][2]), math.Asin(sy), math.Atan2(-rows[0][1], rows[0][0])}
+				}
+			} else {
+				euler = [3]float64{math.Atan2(rows[2][1], rows[1][1]), -math.Pi / 2, 0}
+			}
+		} else {
+			euler = [3]float64{math.Atan2(rows[2][1], rows[1][1]), math.Pi / 2, 0}
+		}
+	case zerogdscript.EulerOrderXZY:
+		// rot =  cz*cy             -sz             cz*sy
+		//        sx*sy+cx*cy*sz    cx*cz           cx*sz*sy-cy*sx
+		//        cy*sx*sz          cz*sx           cx*cy+sx*sz*sy
+		sz := rows[0][1]
+		if sz < lock {
+			if sz > -lock {
+				euler = [3]float64{math.Atan2(rows[2][1], rows[1][1]), math.Atan2(rows[0][2], rows[0][0]), math.Asin(-sz)}
+			} else {
+				// It's -1
+				euler = [3]float64{-math.Atan2(rows[1][2], rows[2][2]), 0, math.Pi / 2}
+			}
+		} else {
+			// It's 1
+			euler = [3]float64{-math.Atan2(rows[1][2], rows[2][2]), 0, -math.Pi / 2}
+		}
+	case zerogdscript.EulerOrderYXZ:
+		// rot =  cy*cz+sy*sx*sz    cz*sy*sx-cy*sz        cx*sy
+		//        cx*sz             cx*cz                 -sx
+		//        cy*sx*sz-cz*sy    cy*cz*sx+sy*sz        cy*cx
+		m12 := rows[1][2]
+		if m12 < lock {
+			if m12 > -lock {
+				// is this a pure X rotation?
+				if rows[1][0] == 0 && rows[0][1] == 0 && rows[0][2] == 0 && rows[2][0] == 0 && rows[0][0] == 1 {
+					// return the simplest form (human friendlier in editor and scripts)
+					euler = [3]float64{math.Atan2(-m12, rows[1][1]), 0, 0}
+				} else {
+					euler = [3]float64{math.Asin(-m12), math.Atan2(rows[0][2], rows[2][2]), math.Atan2(rows[1][0], rows[1][1])}
+				}
+			} else { // m12 == -1
+				euler = [3]float64{math.Pi * 0.5, math.Atan2(rows[0][1], rows[0][0]), 0}
+			}
+		} else { // m12 == 1
+			euler = [3]float64{-math.Pi * 0.5, -math.Atan2(rows[0][1], rows[0][0]), 0}
+		}
+	case zerogdscript.EulerOrderYZX:
+		// rot =  cy*cz             sy*sx-cy*cx*sz     cx*sy+cy*sz*sx
+		//        sz                cz*cx              -cz*sx
+		//        -cz*sy            cy*sx+cx*sy*sz     cy*cx-sy*sz*sx
+		sz := rows[1][0]
+		if sz < lock {
+			if sz > -lock {
+				euler = [3]float64{math.Atan2(-rows[1][2], rows[1][1]), math.Atan2(-rows[2][0], rows[0][0]), math.Asin(sz)}
+			} else {
+				// It's -1
+				euler = [3]float64{math.Atan2(rows[2][1], rows[2][2]), 0, -math.Pi / 2}
+			}
+		} else {
+			// It's 1
+			euler = [3]float64{math.Atan2(rows[2][1], rows[2][2]), 0, math.Pi / 2}
+		}
+	case zerogdscript.EulerOrderZXY:
+		// rot =  cz*cy-sz*sx*sy    -cx*sz                cz*sy+cy*sz*sx
+		//        cy*sz+cz*sx*sy    cz*cx                 sz*sy-cz*cy*sx
+		//        -cx*sy            sx                    cx*cy
+		sx := rows[2][1]
+		if sx < lock {
+			if sx > -lock {
+				euler = [3]float64{math.Asin(sx), math.Atan2(-rows[2][0], rows[2][2]), math.Atan2(-rows[0][1], rows[1][1])}
+			} else {
+				// It's -1
+				euler = [3]float64{-math.Pi / 2, math.Atan2(rows[0][2], rows[0][0]), 0}
+			}
+		} else {
+			// It's 1
+			euler = [3]float64{math.Pi / 2, math.Atan2(rows[0][2], rows[0][0]), 0}
+		}
+	case zerogdscript.EulerOrderZYX:
+		// rot =  cz*cy             cz*sy*sx-cx*sz        sz*sx+cz*cx*cy
+		//        cy*sz             cz*cx+sz*sy*sx        cx*sz*sy-cz*sx
+		//        -sy               cy*sx                 cy*cx
+		sy := rows[2][0]
+		if sy < lock {
+			if sy > -lock {
+				euler = [3]float64{math.Atan2(rows[2][1], rows[2][2]), math.Asin(-sy), math.Atan2(rows[1][0], rows[0][0])}
+			} else {
+				// It's -1
+				euler = [3]float64{0, math.Pi / 2, -math.Atan2(rows[0][1], rows[1][1])}
+			}
+		} else {
+			// It's 1
+			euler = [3]float64{0, -math.Pi / 2, -math.Atan2(rows[0][1], rows[1][1])}
+		}
+	}
+	return euler
+}
+
+// Mul returns the matrix product b * m, like Godot's Basis::operator*. The
+// result applies m first and then b: b.Mul(m).Xform(v) == b.Xform(m.Xform(v)).
+func (b Basis) Mul(m Basis) Basis {
+	var res Basis
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			res.Rows[i][j] = b.Rows[i][0]*m.Rows[0][j] + b.Rows[i][1]*m.Rows[1][j] + b.Rows[i][2]*m.Rows[2][j]
+		}
+	}
+	return res
+}
+
+// FromScale returns the basis that scales each axis by the matching component
+// of scale.
+func FromScale(scale [3]float64) Basis {
+	var b Basis
+	b.Set(scale[0], 0, 0, 0, scale[1], 0, 0, 0, scale[2])
+	return b
+}
+
+// Scaled returns the basis scaled by scale along the global axes, that is
+// FromScale(scale).Mul(b).
+func (b Basis) Scaled(scale [3]float64) Basis {
+	return FromScale(scale).Mul(b)
+}
+
+// ScaledLocal returns the basis scaled by scale along its own axes, that is
+// b.Mul(FromScale(scale)). After a rotation this scales along the rotated
+// axes, where Scaled scales along the global ones.
+func (b Basis) ScaledLocal(scale [3]float64) Basis {
+	return b.Mul(FromScale(scale))
+}
+
+// Rotated returns the basis rotated around the global axis by angle in
+// radians, that is FromAxisAndAngle(axis, angle).Mul(b). A zero axis leaves
+// the basis unchanged.
+func (b Basis) Rotated(axis [3]float64, angle float64) Basis {
+	return FromAxisAndAngle(axis, angle).Mul(b)
+}
+
+// RotatedLocal returns the basis rotated around axis by angle in radians,
+// with axis given in the basis's own frame, that is
+// b.Mul(FromAxisAndAngle(axis, angle)). A zero axis leaves the basis unchanged.
+func (b Basis) RotatedLocal(axis [3]float64, angle float64) Basis {
+	return b.Mul(FromAxisAndAngle(axis, angle))
+}
+
 func (b *Basis) Set(pXX, pXY, pXZ, pYX, pYY, pYZ, pZX, pZY, pZZ float64) {
 	b.Rows[0] = [3]float64{pXX, pXY, pXZ}
 	b.Rows[1] = [3]float64{pYX, pYY, pYZ}
@@ -103,6 +315,18 @@ func (b *Basis) SetColumn(index int, value [3]float64) {
 	b.Rows[2][index] = value[2]
 }
 
+// Transposed returns the transpose of the basis matrix.
+// For a pure rotation this is also its inverse.
+func (b Basis) Transposed() Basis {
+	return Basis{
+		Rows: [3][3]float64{
+			{b.Rows[0][0], b.Rows[1][0], b.Rows[2][0]},
+			{b.Rows[0][1], b.Rows[1][1], b.Rows[2][1]},
+			{b.Rows[0][2], b.Rows[1][2], b.Rows[2][2]},
+		},
+	}
+}
+
 // GetMainDiagonal returns the main diagonal of the basis matrix.
 func (b Basis) GetMainDiagonal() []float64 {
 	return []float64{b.Rows[0][0], b.Rows[1][1], b.Rows[2][2]}
@@ -132,12 +356,13 @@ func (b Basis) TransposeXform(m Basis) Basis {
 }
 
 // Set the basis matrix to represent a rotation around the given axis by the specified angle.
+// A non-normalized axis is normalized first; a zero axis sets the identity.
 func (b *Basis) SetAxisAngle(axis [3]float64, angle float64) {
-	// Ensure axis is normalized (optional check)
-	// if !axis.IsNormalized() {
-	//     // Optionally handle error
-	//     return
-	// }
+	axis, err := unitAxis(axis)
+	if err != nil {
+		*b = New()
+		return
+	}
 
 	// Compute squared components of the axis
 	axisSq := [3]float64{axis[0] * axis[0], axis[1] * axis[1], axis[2] * axis[2]}
@@ -171,6 +396,9 @@ func (b *Basis) SetAxisAngle(axis [3]float64, angle float64) {
 }
 
 func (b Basis) Xform(pVector [3]float64) [3]float64 {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Basis.Xform", b)
+	}
 	return [3]float64{
 		utils.Dot3(b.Rows[0], pVector),
 		utils.Dot3(b.Rows[1], pVector),
@@ -184,32 +412,351 @@ func (b *Basis) Determinant() float64 {
 		b.Rows[2][0]*(b.Rows[0][1]*b.Rows[1][2]-b.Rows[1][1]*b.Rows[0][2])
 }
 
-// cofac calculates the cofactor of a 3x3 matrix.
-func cofac(rows [3][3]float64, row1, col1, row2, col2 int) float64 {
-	return rows[row1][col1]*rows[row2][col2] - rows[row1][col2]*rows[row2][col1]
+// GetScaleAbs returns the length of each column, the absolute scale along
+// each basis axis.
+func (b Basis) GetScaleAbs() [3]float64 {
+	var scale [3]float64
+	for i := range scale {
+		scale[i] = math.Sqrt(b.Rows[0][i]*b.Rows[0][i] + b.Rows[1][i]*b.Rows[1][i] + b.Rows[2][i]*b.Rows[2][i])
+	}
+	return scale
+}
+
+// GetScale returns the scale of the basis. As in Godot, the sign of the
+// determinant is applied to every component, so a mirrored basis gets an
+// all-negative scale and dividing each column by its scale always leaves a
+// proper rotation.
+func (b Basis) GetScale() [3]float64 {
+	scale := b.GetScaleAbs()
+	detSign := zerogdscript.Sign(b.Determinant())
+	for i := range scale {
+		scale[i] *= detSign
+	}
+	return scale
+}
+
+// Orthonormalize makes the columns of the basis mutually orthogonal and of
+// unit length with the Gram-Schmidt process, as Godot does: X is normalized,
+// then Y and Z have the components along the previous axes removed. This
+// removes scale and the skew that builds up when rotations are accumulated.
+// It returns ErrSingular and leaves the basis unchanged if the determinant
+// is zero.
+func (b *Basis) Orthonormalize() error {
+	if b.Determinant() == 0 {
+		return zerogdscript.ErrSingular
+	}
+	col := func(i int) [3]float64 {
+		return [3]float64{b.Rows[0][i], b.Rows[1][i], b.Rows[2][i]}
+	}
+	x, y, z := col(0), col(1), col(2)
+
+	x, _ = unitAxis(x)
+	xy := utils.Dot3(x, y)
+	for i := range y {
+		y[i] -= x[i] * xy
+	}
+	y, _ = unitAxis(y)
+	xz, yz := utils.Dot3(x, z), utils.Dot3(y, z)
+	for i := range z {
+		z[i] -= x[i]*xz + y[i]*yz
+	}
+	z, _ = unitAxis(z)
+
+	b.SetColumns(x, y, z)
+	return nil
+}
+
+// Orthonormalized returns a copy of the basis with orthogonal, unit-length
+// columns. See Orthonormalize; a singular basis is returned unchanged.
+func (b Basis) Orthonormalized() Basis {
+	b.Orthonormalize()
+	return b
+}
+
+// Validate returns a *zerogdscript.ValidationError naming the first element
+// that is NaN or infinite, or wrapping ErrSingular if the determinant is zero.
+func (b Basis) Validate() error {
+	for i, row := range b.Rows {
+		for j, x := range row {
+			if math.IsNaN(x) || math.IsInf(x, 0) {
+				return zerogdscript.ValidateFinite("Basis", fmt.Sprintf("Rows[%d][%d]", i, j), x)
+			}
+		}
+	}
+	if b.Determinant() == 0 {
+		return &zerogdscript.ValidationError{Type: "Basis", Field: "Determinant", Err: zerogdscript.ErrSingular}
+	}
+	return nil
+}
+
+// unitAxis returns axis with unit length, leaving it untouched when it is
+// already normalized within tolerance.
+func unitAxis(axis [3]float64) ([3]float64, error) {
+	lsq := utils.Dot3(axis, axis)
+	if zerogdscript.IsEqualApprox(lsq, 1) {
+		return axis, nil
+	}
+	if lsq == 0 {
+		return axis, zerogdscript.ErrZeroLength
+	}
+	l := math.Sqrt(lsq)
+	return [3]float64{axis[0] / l, axis[1] / l, axis[2] / l}, nil
 }
 
 // Invert inverts the Basis matrix.
+// It returns ErrSingular and leaves the basis unchanged if the determinant is zero.
 func (b *Basis) Invert() error {
-	co := [3]float64{
-		cofac(b.Rows, 1, 1, 2, 2),
-		cofac(b.Rows, 1, 2, 2, 0),
-		cofac(b.Rows, 1, 0, 2, 1),
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Basis.Invert", *b)
 	}
-
-	det := b.Rows[0][0]*co[0] + b.Rows[0][1]*co[1] + b.Rows[0][2]*co[2]
+	adj, det := core.Adjugate(b.Rows)
 
 	// Check for zero determinant
 	if det == 0 {
-		return errors.New("matrix is not invertible, determinant is zero")
+		return zerogdscript.ErrSingular
 	}
 
 	s := 1.0 / det
-
-	// Set the new values of the matrix
-	b.Rows[0] = [3]float64{co[0] * s, cofac(b.Rows, 0, 2, 2, 1) * s, cofac(b.Rows, 0, 1, 1, 2) * s}
-	b.Rows[1] = [3]float64{co[1] * s, cofac(b.Rows, 0, 0, 2, 2) * s, cofac(b.Rows, 0, 2, 1, 0) * s}
-	b.Rows[2] = [3]float64{co[2] * s, cofac(b.Rows, 0, 1, 2, 0) * s, cofac(b.Rows, 0, 0, 1, 1) * s}
+	for i := range adj {
+		for j := range adj[i] {
+			adj[i][j] *= s
+		}
+	}
+	b.Rows = adj
 
 	return nil
 }
+
+// GetQuaternion returns the rotation of the basis as a quaternion (x, y, z, w),
+// using Godot's trace-based method. The basis is expected to be a pure
+// rotation. quaternion.FromBasis wraps this as a Quaternion.
+func (b Basis) GetQuaternion() [4]float64 {
+	trace := b.Rows[0][0] + b.Rows[1][1] + b.Rows[2][2]
+	var temp [4]float64
+
+	if trace > 0.0 {
+		s := math.Sqrt(trace + 1.0)
+		temp[3] = s * 0.5
+		s = 0.5 / s
+
+		temp[0] = (b.Rows[2][1] - b.Rows[1][2]) * s
+		temp[1] = (b.Rows[0][2] - b.Rows[2][0]) * s
+		temp[2] = (b.Rows[1][0] - b.Rows[0][1]) * s
+	} else {
+		i := 0
+		if b.Rows[0][0] < b.Rows[1][1] {
+			i = 1
+			if b.Rows[1][1] < b.Rows[2][2] {
+				i = 2
+			}
+		} else if b.Rows[0][0] < b.Rows[2][2] {
+			i = 2
+		}
+		j := (i + 1) % 3
+		k := (i + 2) % 3
+
+		s := math.Sqrt(b.Rows[i][i] - b.Rows[j][j] - b.Rows[k][k] + 1.0)
+		temp[i] = s * 0.5
+		s = 0.5 / s
+
+		temp[3] = (b.Rows[k][j] - b.Rows[j][k]) * s
+		temp[j] = (b.Rows[j][i] + b.Rows[i][j]) * s
+		temp[k] = (b.Rows[k][i] + b.Rows[i][k]) * s
+	}
+
+	return temp
+}
+
+// FromQuaternion returns the rotation basis of the quaternion (x, y, z, w).
+// A zero quaternion returns the identity basis. Quaternion.ToBasis wraps this.
+func FromQuaternion(q [4]float64) Basis {
+	d := q[0]*q[0] + q[1]*q[1] + q[2]*q[2] + q[3]*q[3]
+	if d == 0 {
+		return New()
+	}
+	s := 2.0 / d
+	xs, ys, zs := q[0]*s, q[1]*s, q[2]*s
+	wx, wy, wz := q[3]*xs, q[3]*ys, q[3]*zs
+	xx, xy, xz := q[0]*xs, q[0]*ys, q[0]*zs
+	yy, yz, zz := q[1]*ys, q[1]*zs, q[2]*zs
+
+	var b Basis
+	b.Set(
+		1.0-(yy+zz), xy-wz, xz+wy,
+		xy+wz, 1.0-(xx+zz), yz-wx,
+		xz-wy, yz+wx, 1.0-(xx+yy),
+	)
+	return b
+}
+
+// Slerp interpolates between this basis and to by weight.
+// Both bases are decomposed as M = R.S; the rotations are interpolated with a
+// quaternion slerp and the scales linearly, then recomposed.
+func (b Basis) Slerp(to Basis, weight float64) Basis {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Basis.Slerp", b, to)
+	}
+	fromRot, fromScale := b.decompose()
+	toRot, toScale := to.decompose()
+
+	res := FromQuaternion(slerpQuaternion(fromRot.GetQuaternion(), toRot.GetQuaternion(), weight))
+	for i := 0; i < 3; i++ {
+		s := fromScale[i] + (toScale[i]-fromScale[i])*weight
+		res.Rows[0][i] *= s
+		res.Rows[1][i] *= s
+		res.Rows[2][i] *= s
+	}
+	return res
+}
+
+// decompose splits the basis into a proper rotation and a scale, M = R.S.
+// Degenerate (zero-length or dependent) columns get a perpendicular axis so R
+// is always a rotation, and a reflection is carried by a single scale axis.
+func (b Basis) decompose() (Basis, [3]float64) {
+	sub := func(a, b [3]float64, s float64) [3]float64 {
+		return [3]float64{a[0] - b[0]*s, a[1] - b[1]*s, a[2] - b[2]*s}
+	}
+	var axes [3][3]float64
+	var done [3]bool
+	// orthogonalize removes the components of v along the axes built so far and
+	// returns the unit remainder, or false if nothing usable is left.
+	orthogonalize := func(v [3]float64) ([3]float64, bool) {
+		for k := 0; k < 3; k++ {
+			if done[k] {
+				v = sub(v, axes[k], utils.Dot3(v, axes[k]))
+			}
+		}
+		l := math.Sqrt(utils.Dot3(v, v))
+		if l <= zerogdscript.CMP_EPSILON {
+			return v, false
+		}
+		return [3]float64{v[0] / l, v[1] / l, v[2] / l}, true
+	}
+
+	var cols [3][3]float64
+	for i := 0; i < 3; i++ {
+		cols[i] = [3]float64{b.Rows[0][i], b.Rows[1][i], b.Rows[2][i]}
+		axes[i], done[i] = orthogonalize(cols[i])
+	}
+
+	for i := 0; i < 3; i++ {
+		if done[i] {
+			continue
+		}
+		j, k := (i+1)%3, (i+2)%3
+		if done[j] && done[k] {
+			axes[i] = utils.Cross3(axes[j], axes[k])
+		} else {
+			for n := 0; n < 3; n++ {
+				var e [3]float64
+				e[(i+n)%3] = 1
+				if axis, ok := orthogonalize(e); ok && utils.Dot3(axis, e) > 0.5 {
+					axes[i] = axis
+					break
+				}
+			}
+		}
+		done[i] = true
+	}
+
+	var rot Basis
+	rot.SetColumns(axes[0], axes[1], axes[2])
+	if rot.Determinant() < 0 {
+		// Flip the axis that leaves the smallest rotation.
+		k := 0
+		for i := 1; i < 3; i++ {
+			if axes[i][i] < axes[k][k] {
+				k = i
+			}
+		}
+		axes[k] = [3]float64{-axes[k][0], -axes[k][1], -axes[k][2]}
+		rot.SetColumn(k, axes[k])
+	}
+
+	var scale [3]float64
+	for i := 0; i < 3; i++ {
+		scale[i] = utils.Dot3(cols[i], axes[i])
+	}
+	return rot, scale
+}
+
+// slerpQuaternion spherically interpolates between two unit quaternions (x, y, z, w),
+// taking the shorter arc and falling back to a linear blend when they are nearly equal.
+func slerpQuaternion(from, to [4]float64, weight float64) [4]float64 {
+	cosom := from[0]*to[0] + from[1]*to[1] + from[2]*to[2] + from[3]*to[3]
+	if cosom < 0.0 {
+		cosom = -cosom
+		to = [4]float64{-to[0], -to[1], -to[2], -to[3]}
+	}
+
+	scale0, scale1 := 1.0-weight, weight
+	if 1.0-cosom > zerogdscript.CMP_EPSILON {
+		omega := math.Acos(cosom)
+		sinom := math.Sin(omega)
+		scale0 = math.Sin((1.0-weight)*omega) / sinom
+		scale1 = math.Sin(weight*omega) / sinom
+	}
+
+	return [4]float64{
+		scale0*from[0] + scale1*to[0],
+		scale0*from[1] + scale1*to[1],
+		scale0*from[2] + scale1*to[2],
+		scale0*from[3] + scale1*to[3],
+	}
+}
+
+// AsArrayRowMajor returns the matrix row by row, the order Basis stores it in:
+// [Rows[0][0], Rows[0][1], Rows[0][2], Rows[1][0], ...].
+func (b Basis) AsArrayRowMajor() [9]float64 {
+	r := b.Rows
+	return [9]float64{
+		r[0][0], r[0][1], r[0][2],
+		r[1][0], r[1][1], r[1][2],
+		r[2][0], r[2][1], r[2][2],
+	}
+}
+
+// AsArrayColumnMajor returns the matrix column by column, the order OpenGL
+// and Vulkan expect: the X axis column first, then Y, then Z.
+func (b Basis) AsArrayColumnMajor() [9]float64 {
+	return b.Transposed().AsArrayRowMajor()
+}
+
+// FromArrayRowMajor is the inverse of AsArrayRowMajor.
+func FromArrayRowMajor(a [9]float64) Basis {
+	return Basis{Rows: [3][3]float64{
+		{a[0], a[1], a[2]},
+		{a[3], a[4], a[5]},
+		{a[6], a[7], a[8]},
+	}}
+}
+
+// FromArrayColumnMajor is the inverse of AsArrayColumnMajor.
+func FromArrayColumnMajor(a [9]float64) Basis {
+	return FromArrayRowMajor(a).Transposed()
+}
+
+// ToFloat32Slice returns the nine elements in the AsArrayRowMajor order,
+// converted to float32 for GPU buffers and FFI. Use
+// Transposed().ToFloat32Slice() for a column-major buffer.
+func (b Basis) ToFloat32Slice() []float32 {
+	a := b.AsArrayRowMajor()
+	s := make([]float32, len(a))
+	for i, x := range a {
+		s[i] = float32(x)
+	}
+	return s
+}
+
+// FromFloat32Slice is the inverse of ToFloat32Slice. It returns
+// ErrInvalidLength and the identity unless s has exactly nine elements.
+func FromFloat32Slice(s []float32) (Basis, error) {
+	var a [9]float64
+	if len(s) != len(a) {
+		return New(), zerogdscript.ErrInvalidLength
+	}
+	for i, x := range s {
+		a[i] = float64(x)
+	}
+	return FromArrayRowMajor(a), nil
+}
@@ -1,6 +1,201 @@
 package basis
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+)
+
+func basisIsEqualApprox(a, b Basis) bool {
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if !zerogdscript.IsEqualApprox(a.Rows[i][j], b.Rows[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestBasis_GetEuler(t *testing.T) {
+	orders := []struct {
+		name   string
+		order  zerogdscript.EulerOrder
+		middle int // the axis applied second, limited to [-PI/2, PI/2]
+	}{
+		{"XYZ", zerogdscript.EulerOrderXYZ, 1},
+		{"XZY", zerogdscript.EulerOrderXZY, 2},
+		{"YXZ", zerogdscript.EulerOrderYXZ, 0},
+		{"YZX", zerogdscript.EulerOrderYZX, 2},
+		{"ZXY", zerogdscript.EulerOrderZXY, 0},
+		{"ZYX", zerogdscript.EulerOrderZYX, 1},
+	}
+	outer := []float64{-2.5, -1, -0.3, 0, 0.4, 1.2, 3}
+	inner := []float64{-1.2, -0.5, 0, 0.25, 1.1}
+	for _, o := range orders {
+		for _, a := range outer {
+			for _, m := range inner {
+				for _, c := range outer {
+					var euler [3]float64
+					euler[o.middle] = m
+					euler[(o.middle+1)%3] = a
+					euler[(o.middle+2)%3] = c
+					b := FromEuler(euler, o.order)
+					got := b.GetEuler(o.order)
+					for i := range got {
+						if !zerogdscript.IsEqualApprox(got[i], euler[i]) {
+							t.Fatalf("%s: GetEuler(FromEuler(%v)) = %v", o.name, euler, got)
+						}
+					}
+				}
+			}
+		}
+
+		// At gimbal lock the angles are not unique, but they must rebuild the basis.
+		for _, lock := range []float64{-math.Pi / 2, math.Pi / 2} {
+			for _, a := range outer {
+				var euler [3]float64
+				euler[o.middle] = lock
+				euler[(o.middle+1)%3] = a
+				euler[(o.middle+2)%3] = 0.7
+				b := FromEuler(euler, o.order)
+				got := b.GetEuler(o.order)
+				if !zerogdscript.IsEqualApprox(got[o.middle], lock) {
+					t.Errorf("%s: gimbal lock %v gave middle angle %v", o.name, euler, got[o.middle])
+				}
+				if rebuilt := FromEuler(got, o.order); !basisIsEqualApprox(rebuilt, b) {
+					t.Errorf("%s: FromEuler(GetEuler(b)) = %v, want %v for %v", o.name, rebuilt.Rows, b.Rows, euler)
+				}
+			}
+		}
+	}
+
+	// The orders differ: YXZ applies Y last in the product, so it is not XYZ.
+	euler := [3]float64{0.3, 0.5, 0.7}
+	if basisIsEqualApprox(FromEuler(euler, zerogdscript.EulerOrderXYZ), FromEuler(euler, zerogdscript.EulerOrderYXZ)) {
+		t.Errorf("XYZ and YXZ produced the same basis")
+	}
+	// A single rotation matches the axis-angle constructor.
+	if got, want := FromEuler([3]float64{0, 0.8, 0}, zerogdscript.EulerOrderYXZ), FromAxisAndAngle([3]float64{0, 1, 0}, 0.8); !basisIsEqualApprox(got, want) {
+		t.Errorf("FromEuler(Y 0.8) = %v, want %v", got.Rows, want.Rows)
+	}
+	if got := FromEuler(euler, zerogdscript.EulerOrder(42)); got != New() {
+		t.Errorf("FromEuler with an unknown order = %v, want identity", got.Rows)
+	}
+	if got := New().GetEuler(zerogdscript.EulerOrder(42)); got != [3]float64{} {
+		t.Errorf("GetEuler with an unknown order = %v, want zeros", got)
+	}
+}
+
+func TestBasis_GetScale(t *testing.T) {
+	rot := FromEuler([3]float64{0.3, -1.1, 2.2}, zerogdscript.EulerOrderYXZ)
+	scaled := func(s [3]float64) Basis {
+		b := rot
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				b.Rows[i][j] *= s[j]
+			}
+		}
+		return b
+	}
+	for _, tt := range []struct {
+		scale, want, wantAbs [3]float64
+	}{
+		{[3]float64{1, 1, 1}, [3]float64{1, 1, 1}, [3]float64{1, 1, 1}},
+		{[3]float64{2, 0.5, 3}, [3]float64{2, 0.5, 3}, [3]float64{2, 0.5, 3}},
+		// Mirrored: the determinant's sign goes on every component.
+		{[3]float64{2, -3, 4}, [3]float64{-2, -3, -4}, [3]float64{2, 3, 4}},
+		{[3]float64{-1, -1, -1}, [3]float64{-1, -1, -1}, [3]float64{1, 1, 1}},
+		// Two negative axes are a rotation, not a mirror.
+		{[3]float64{-2, -3, 4}, [3]float64{2, 3, 4}, [3]float64{2, 3, 4}},
+	} {
+		b := scaled(tt.scale)
+		got, gotAbs := b.GetScale(), b.GetScaleAbs()
+		for i := range got {
+			if !zerogdscript.IsEqualApprox(got[i], tt.want[i]) || !zerogdscript.IsEqualApprox(gotAbs[i], tt.wantAbs[i]) {
+				t.Fatalf("scale %v: GetScale = %v, GetScaleAbs = %v, want %v and %v", tt.scale, got, gotAbs, tt.want, tt.wantAbs)
+			}
+		}
+		// Dividing out the scale leaves a proper rotation.
+		r := b
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				r.Rows[i][j] /= got[j]
+			}
+		}
+		if det := r.Determinant(); !zerogdscript.IsEqualApprox(det, 1) {
+			t.Errorf("scale %v: basis without its scale has determinant %v, want 1", tt.scale, det)
+		}
+	}
+	if got := (Basis{}).GetScale(); got != [3]float64{} {
+		t.Errorf("zero basis GetScale = %v, want zeros", got)
+	}
+}
+
+func TestBasis_Orthonormalized(t *testing.T) {
+	rot := FromEuler([3]float64{0.4, 1.2, -0.7}, zerogdscript.EulerOrderYXZ)
+	skewed := rot
+	skewed.Rows[0][1] += 0.01
+	skewed.Rows[1][2] -= 0.02
+	skewed.Rows[2][0] += 0.015
+	skewed.Rows[1][1] *= 1.03
+
+	mirrored := skewed
+	for i := 0; i < 3; i++ {
+		mirrored.Rows[i][2] = -mirrored.Rows[i][2]
+	}
+
+	for name, tt := range map[string]struct {
+		in      Basis
+		wantDet float64
+	}{
+		"rotation": {rot, 1},
+		"skewed":   {skewed, 1},
+		"mirrored": {mirrored, -1},
+	} {
+		got := tt.in.Orthonormalized()
+		for i := 0; i < 3; i++ {
+			ci := [3]float64{got.Rows[0][i], got.Rows[1][i], got.Rows[2][i]}
+			for j := i; j < 3; j++ {
+				cj := [3]float64{got.Rows[0][j], got.Rows[1][j], got.Rows[2][j]}
+				want := 0.0
+				if i == j {
+					want = 1
+				}
+				if d := ci[0]*cj[0] + ci[1]*cj[1] + ci[2]*cj[2]; !zerogdscript.IsEqualApprox(d, want) {
+					t.Errorf("%s: column %d . column %d = %v, want %v", name, i, j, d, want)
+				}
+			}
+		}
+		if det := got.Determinant(); !zerogdscript.IsEqualApprox(det, tt.wantDet) {
+			t.Errorf("%s: determinant = %v, want %v", name, det, tt.wantDet)
+		}
+		// The X column keeps its direction.
+		l := math.Sqrt(tt.in.Rows[0][0]*tt.in.Rows[0][0] + tt.in.Rows[1][0]*tt.in.Rows[1][0] + tt.in.Rows[2][0]*tt.in.Rows[2][0])
+		for i := 0; i < 3; i++ {
+			if !zerogdscript.IsEqualApprox(got.Rows[i][0], tt.in.Rows[i][0]/l) {
+				t.Errorf("%s: X column = %v, want the normalized input X column", name, got.GetColumn(0))
+				break
+			}
+		}
+	}
+
+	if got := rot.Orthonormalized(); !basisIsEqualApprox(got, rot) {
+		t.Errorf("Orthonormalized changed a pure rotation: %v, want %v", got, rot)
+	}
+
+	singular := Basis{Rows: [3][3]float64{{1, 2, 3}, {2, 4, 6}, {0, 0, 1}}}
+	b := singular
+	if err := b.Orthonormalize(); !errors.Is(err, zerogdscript.ErrSingular) {
+		t.Errorf("Orthonormalize(singular) error = %v, want ErrSingular", err)
+	}
+	if b != singular {
+		t.Errorf("Orthonormalize(singular) modified the basis: %v", b)
+	}
+}
 
 func TestBasis_Set(t *testing.T) {}
 
@@ -18,10 +213,396 @@ func TestBasis_SetAxisAngle(t *testing.T) {}
 
 func TestBasis_Xform(t *testing.T) {}
 
+func TestBasis_Mul(t *testing.T) {
+	a := FromEuler([3]float64{0.3, -0.8, 1.4}, zerogdscript.EulerOrderYXZ)
+	a.Rows[0][1] += 0.5 // add some skew so the product is not a pure rotation
+	b := Basis{Rows: [3][3]float64{{2, 0.1, 0}, {0, -1, 0.3}, {0.4, 0, 1.5}}}
+
+	for _, v := range [][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {1.5, -2, 0.25}} {
+		got := a.Mul(b).Xform(v)
+		want := a.Xform(b.Xform(v))
+		for i := range got {
+			if !zerogdscript.IsEqualApprox(got[i], want[i]) {
+				t.Fatalf("a.Mul(b).Xform(%v) = %v, want %v", v, got, want)
+			}
+		}
+	}
+
+	if got := a.Mul(b); basisIsEqualApprox(got, b.Mul(a)) {
+		t.Errorf("a.Mul(b) == b.Mul(a); the operands should not commute")
+	}
+	if got := a.Mul(New()); got != a {
+		t.Errorf("a.Mul(identity) = %v, want %v", got, a)
+	}
+	if got := New().Mul(a); got != a {
+		t.Errorf("identity.Mul(a) = %v, want %v", got, a)
+	}
+}
+
+func TestBasis_ScaledLocal(t *testing.T) {
+	// A quarter turn around Z takes the local X axis to global +Y.
+	r := FromAxisAndAngle([3]float64{0, 0, 1}, math.Pi/2)
+	scale := [3]float64{2, 1, 1}
+	check := func(name string, b Basis, v, want [3]float64) {
+		t.Helper()
+		got := b.Xform(v)
+		for i := range got {
+			if !zerogdscript.IsEqualApprox(got[i], want[i]) {
+				t.Errorf("%s.Xform(%v) = %v, want %v", name, v, got, want)
+				return
+			}
+		}
+	}
+
+	// Locally, the stretch follows the rotated X axis, which now points up.
+	local := r.ScaledLocal(scale)
+	check("ScaledLocal", local, [3]float64{1, 0, 0}, [3]float64{0, 2, 0})
+	check("ScaledLocal", local, [3]float64{0, 1, 0}, [3]float64{-1, 0, 0})
+
+	// Globally, the stretch stays on the world X axis.
+	global := r.Scaled(scale)
+	check("Scaled", global, [3]float64{1, 0, 0}, [3]float64{0, 1, 0})
+	check("Scaled", global, [3]float64{0, 1, 0}, [3]float64{-2, 0, 0})
+
+	if got, want := local.GetScale(), [3]float64{2, 1, 1}; !zerogdscript.IsEqualApprox(got[0], want[0]) || !zerogdscript.IsEqualApprox(got[1], want[1]) {
+		t.Errorf("ScaledLocal scale = %v, want %v", got, want)
+	}
+}
+
+func TestBasis_RotatedLocal(t *testing.T) {
+	// Tip the basis a quarter turn around X, so its local Z points along -Y.
+	b := FromAxisAndAngle([3]float64{1, 0, 0}, math.Pi/2)
+	z := [3]float64{0, 0, 1}
+
+	// Rotating locally around Z spins around the basis's own Z axis, which
+	// is the world -Y axis now.
+	local := b.RotatedLocal(z, math.Pi/2)
+	global := b.Rotated(z, math.Pi/2)
+	want := b.Mul(FromAxisAndAngle(z, math.Pi/2))
+	if !basisIsEqualApprox(local, want) {
+		t.Errorf("RotatedLocal = %v, want %v", local, want)
+	}
+	want = FromAxisAndAngle(z, math.Pi/2).Mul(b)
+	if !basisIsEqualApprox(global, want) {
+		t.Errorf("Rotated = %v, want %v", global, want)
+	}
+
+	// The local X axis turns to the local Y axis, which is world +Z.
+	got := local.Xform([3]float64{1, 0, 0})
+	for i, w := range [3]float64{0, 0, 1} {
+		if !zerogdscript.IsEqualApprox(got[i], w) {
+			t.Fatalf("RotatedLocal X axis = %v, want (0, 0, 1)", got)
+		}
+	}
+	// The global rotation turns X to world +Y instead.
+	got = global.Xform([3]float64{1, 0, 0})
+	for i, w := range [3]float64{0, 1, 0} {
+		if !zerogdscript.IsEqualApprox(got[i], w) {
+			t.Fatalf("Rotated X axis = %v, want (0, 1, 0)", got)
+		}
+	}
+
+	if basisIsEqualApprox(local, global) {
+		t.Errorf("RotatedLocal and Rotated agree; they should differ for a tipped basis")
+	}
+	if got := b.Rotated([3]float64{}, 1); got != b {
+		t.Errorf("zero axis Rotated = %v, want %v", got, b)
+	}
+}
+
 func TestBasis_rowToVector3(t *testing.T) {}
 
 func TestBasis_Determinant(t *testing.T) {}
 
-func TestBasis_cofac(t *testing.T) {}
+func TestBasis_Transposed(t *testing.T) {
+	var b, want Basis
+	b.Set(1, 2, 3, 4, 5, 6, 7, 8, 9)
+	want.Set(1, 4, 7, 2, 5, 8, 3, 6, 9)
+	if got := b.Transposed(); got != want {
+		t.Errorf("Transposed() = %v, want %v", got.Rows, want.Rows)
+	}
+}
+
+func TestBasis_Invert(t *testing.T) {
+	var b Basis
+	b.Set(2, 0.5, -1, 1, 3, 0.25, 0, -2, 4)
+	inv := b
+	if err := inv.Invert(); err != nil {
+		t.Fatalf("Invert() = %v", err)
+	}
+	// b * inv must be the identity.
+	var prod Basis
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			for k := 0; k < 3; k++ {
+				prod.Rows[i][j] += b.Rows[i][k] * inv.Rows[k][j]
+			}
+		}
+	}
+	if !basisIsEqualApprox(prod, New()) {
+		t.Errorf("b * b.Invert() = %v, want identity", prod.Rows)
+	}
+}
+
+func TestBasis_GetQuaternion(t *testing.T) {
+	for _, axis := range [][3]float64{{1, 0, 0}, {0, 1, 0}, {0, 0, 1}, {0, 0.6, 0.8}} {
+		for _, angle := range []float64{0, 0.5, 2, math.Pi, -2.5} {
+			b := FromAxisAndAngle(axis, angle)
+			if got := FromQuaternion(b.GetQuaternion()); !basisIsEqualApprox(got, b) {
+				t.Errorf("FromQuaternion(GetQuaternion()) for axis %v angle %v = %v, want %v", axis, angle, got.Rows, b.Rows)
+			}
+		}
+	}
+}
+
+func TestBasis_Slerp(t *testing.T) {
+	var from Basis
+	from.Set(2, 0, 0, 0, 2, 0, 0, 0, 2)
+	to := FromAxisAndAngle([3]float64{0, 0, 1}, math.Pi/2)
+
+	if got := from.Slerp(to, 0); !basisIsEqualApprox(got, from) {
+		t.Errorf("Slerp(to, 0) = %v, want %v", got.Rows, from.Rows)
+	}
+	if got := from.Slerp(to, 1); !basisIsEqualApprox(got, to) {
+		t.Errorf("Slerp(to, 1) = %v, want %v", got.Rows, to.Rows)
+	}
+
+	want := FromAxisAndAngle([3]float64{0, 0, 1}, math.Pi/4)
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			want.Rows[i][j] *= 1.5
+		}
+	}
+	if got := from.Slerp(to, 0.5); !basisIsEqualApprox(got, want) {
+		t.Errorf("Slerp(to, 0.5) = %v, want a 45 degree rotation scaled by 1.5: %v", got.Rows, want.Rows)
+	}
+
+	// Non-uniform scale is interpolated per axis.
+	var stretched Basis
+	stretched.Set(1, 0, 0, 0, 3, 0, 0, 0, 1)
+	got := New().Slerp(stretched, 0.5)
+	var wantStretch Basis
+	wantStretch.Set(1, 0, 0, 0, 2, 0, 0, 0, 1)
+	if !basisIsEqualApprox(got, wantStretch) {
+		t.Errorf("Slerp() of a non-uniform scale = %v, want %v", got.Rows, wantStretch.Rows)
+	}
+}
+
+func TestBasis_SlerpRotations(t *testing.T) {
+	orders := []zerogdscript.EulerOrder{zerogdscript.EulerOrderXYZ, zerogdscript.EulerOrderYXZ, zerogdscript.EulerOrderZXY}
+	pairs := [][2][3]float64{
+		{{0, 0, 0}, {0.4, -1.2, 2.9}},
+		{{1.1, 0.3, -0.5}, {-0.7, 2.0, 0.1}},
+		{{0.2, 3.0, 0}, {0.2, -3.0, 0}}, // the short way round crosses +-PI
+	}
+	for _, order := range orders {
+		for _, p := range pairs {
+			from, to := FromEuler(p[0], order), FromEuler(p[1], order)
+			if got := from.Slerp(to, 0); !basisIsEqualApprox(got, from) {
+				t.Errorf("Slerp(%v -> %v, 0) = %v, want %v", p[0], p[1], got.Rows, from.Rows)
+			}
+			if got := from.Slerp(to, 1); !basisIsEqualApprox(got, to) {
+				t.Errorf("Slerp(%v -> %v, 1) = %v, want %v", p[0], p[1], got.Rows, to.Rows)
+			}
+			for _, w := range []float64{0.25, 0.5, 0.8} {
+				mid := from.Slerp(to, w)
+				// A pure rotation is orthonormal with determinant 1.
+				if !basisIsEqualApprox(mid.Mul(mid.Transposed()), New()) {
+					t.Errorf("Slerp(%v -> %v, %v) is not orthonormal: %v", p[0], p[1], w, mid.Rows)
+				}
+				if det := mid.Determinant(); !zerogdscript.IsEqualApprox(det, 1) {
+					t.Errorf("Slerp(%v -> %v, %v) determinant = %v, want 1", p[0], p[1], w, det)
+				}
+			}
+		}
+	}
+}
+
+func TestBasis_SlerpDegenerate(t *testing.T) {
+	half := func(b Basis) Basis {
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				b.Rows[i][j] *= 0.5
+			}
+		}
+		return b
+	}
+
+	// Scaling to zero shrinks without rotating.
+	if got, want := New().Slerp(Basis{}, 0.5), half(New()); !basisIsEqualApprox(got, want) {
+		t.Errorf("Slerp() to the zero basis = %v, want %v", got.Rows, want.Rows)
+	}
+
+	// A single collapsed axis keeps the rotation of the other two.
+	flat := FromAxisAndAngle([3]float64{0, 0, 1}, math.Pi/2)
+	flat.SetColumn(2, [3]float64{0, 0, 0})
+	want := FromAxisAndAngle([3]float64{0, 0, 1}, math.Pi/4)
+	want.SetColumn(2, [3]float64{0, 0, 0.5})
+	if got := New().Slerp(flat, 0.5); !basisIsEqualApprox(got, want) {
+		t.Errorf("Slerp() to a flattened basis = %v, want %v", got.Rows, want.Rows)
+	}
+
+	// A mirror is carried by one axis instead of all three.
+	var mirror, wantMirror Basis
+	mirror.Set(-1, 0, 0, 0, 1, 0, 0, 0, 1)
+	wantMirror.Set(0, 0, 0, 0, 1, 0, 0, 0, 1)
+	if got := New().Slerp(mirror, 0.5); !basisIsEqualApprox(got, wantMirror) {
+		t.Errorf("Slerp() to a mirror = %v, want %v", got.Rows, wantMirror.Rows)
+	}
+	if got := New().Slerp(mirror, 1); !basisIsEqualApprox(got, mirror) {
+		t.Errorf("Slerp(mirror, 1) = %v, want %v", got.Rows, mirror.Rows)
+	}
+}
+
+func TestBasis_InvalidInput(t *testing.T) {
+	var singular Basis
+	singular.Set(1, 2, 3, 2, 4, 6, 0, 0, 1)
+	rotZ := FromAxisAndAngle([3]float64{0, 0, 1}, math.Pi/2)
+
+	tests := []struct {
+		name    string
+		checked func() (Basis, error)
+		want    Basis
+		wantErr error
+	}{
+		{"Invert singular", func() (Basis, error) { b := singular; err := b.Invert(); return b, err }, singular, zerogdscript.ErrSingular},
+		{"FromAxisAndAngle non-normalized", func() (Basis, error) { return FromAxisAndAngleChecked([3]float64{0, 0, 3}, math.Pi/2) }, rotZ, nil},
+		{"FromAxisAndAngle zero", func() (Basis, error) { return FromAxisAndAngleChecked([3]float64{}, math.Pi/2) }, New(), zerogdscript.ErrZeroLength},
+		{"FromAxisAndAngle zero plain", func() (Basis, error) { return FromAxisAndAngle([3]float64{}, math.Pi/2), nil }, New(), nil},
+		{"SetAxisAngle non-normalized", func() (Basis, error) { var b Basis; b.SetAxisAngle([3]float64{0, 0, 0.5}, math.Pi/2); return b, nil }, rotZ, nil},
+	}
+	for _, tt := range tests {
+		got, err := tt.checked()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if !basisIsEqualApprox(got, tt.want) {
+			t.Errorf("%s: got %v, want %v", tt.name, got.Rows, tt.want.Rows)
+		}
+	}
+}
+
+func TestBasis_Validate(t *testing.T) {
+	if err := New().Validate(); err != nil {
+		t.Errorf("New().Validate() = %v, want nil", err)
+	}
+
+	nan := New()
+	nan.Rows[1][2] = math.NaN()
+	singular := New()
+	singular.Rows[2] = [3]float64{0, 0, 0}
+	for _, tt := range []struct {
+		b       Basis
+		want    string
+		wantErr error
+	}{
+		{nan, "Basis.Rows[1][2]: value is not finite: NaN", zerogdscript.ErrNonFinite},
+		{singular, "Basis.Determinant: matrix is not invertible, determinant is zero", zerogdscript.ErrSingular},
+	} {
+		err := tt.b.Validate()
+		if err == nil || err.Error() != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v.Validate() = %v, want %q", tt.b, err, tt.want)
+		}
+	}
+}
+
+func TestBasis_Debug(t *testing.T) {
+	var reports []string
+	zerogdscript.SetDebug(true, func(err error) { reports = append(reports, err.Error()) })
+	defer zerogdscript.SetDebug(false, nil)
+
+	bad := New()
+	bad.Rows[0][0] = math.Inf(1)
+	bad.Xform([3]float64{1, 2, 3})
+	New().Slerp(bad, 0.5)
+	bad.Invert()
+	New().Xform([3]float64{1, 2, 3})
+
+	want := []string{
+		"Basis.Xform: Basis.Rows[0][0]: value is not finite: +Inf",
+		"Basis.Slerp: Basis.Rows[0][0]: value is not finite: +Inf",
+		"Basis.Invert: Basis.Rows[0][0]: value is not finite: +Inf",
+	}
+	if len(reports) != len(want) {
+		t.Fatalf("reports = %q, want %q", reports, want)
+	}
+	for i := range want {
+		if reports[i] != want[i] {
+			t.Errorf("report %d = %q, want %q", i, reports[i], want[i])
+		}
+	}
+}
+
+var benchXform [3]float64
+
+func BenchmarkBasis_Xform(b *testing.B) {
+	m := FromAxisAndAngle([3]float64{0, 1, 0}, 0.5)
+	for i := 0; i < b.N; i++ {
+		benchXform = m.Xform([3]float64{1, 2, 3})
+	}
+}
+
+func BenchmarkBasis_XformDebug(b *testing.B) {
+	zerogdscript.SetDebug(true, nil)
+	defer zerogdscript.SetDebug(false, nil)
+	m := FromAxisAndAngle([3]float64{0, 1, 0}, 0.5)
+	for i := 0; i < b.N; i++ {
+		benchXform = m.Xform([3]float64{1, 2, 3})
+	}
+}
+
+func TestBasis_AsArray(t *testing.T) {
+	b := Basis{Rows: [3][3]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}}
+
+	if got, want := b.AsArrayRowMajor(), [9]float64{1, 2, 3, 4, 5, 6, 7, 8, 9}; got != want {
+		t.Errorf("AsArrayRowMajor() = %v, want %v", got, want)
+	}
+	// The first column is the X axis: Rows[0][0], Rows[1][0], Rows[2][0].
+	if got, want := b.AsArrayColumnMajor(), [9]float64{1, 4, 7, 2, 5, 8, 3, 6, 9}; got != want {
+		t.Errorf("AsArrayColumnMajor() = %v, want %v", got, want)
+	}
+	if got, want := b.AsArrayColumnMajor(), b.Transposed().AsArrayRowMajor(); got != want {
+		t.Errorf("column-major %v is not the transposed row-major %v", got, want)
+	}
+
+	if got := FromArrayRowMajor(b.AsArrayRowMajor()); got != b {
+		t.Errorf("FromArrayRowMajor(AsArrayRowMajor()) = %v, want %v", got, b)
+	}
+	if got := FromArrayColumnMajor(b.AsArrayColumnMajor()); got != b {
+		t.Errorf("FromArrayColumnMajor(AsArrayColumnMajor()) = %v, want %v", got, b)
+	}
+	if got, want := FromArrayColumnMajor(b.AsArrayRowMajor()), b.Transposed(); got != want {
+		t.Errorf("FromArrayColumnMajor(row-major) = %v, want the transpose %v", got, want)
+	}
+}
+
+func TestBasis_Float32Slice(t *testing.T) {
+	b := Basis{Rows: [3][3]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}}
+	s := b.ToFloat32Slice()
+	if want := []float32{1, 2, 3, 4, 5, 6, 7, 8, 9}; !reflect.DeepEqual(s, want) {
+		t.Errorf("ToFloat32Slice() = %v, want %v", s, want)
+	}
+	if got, err := FromFloat32Slice(s); err != nil || got != b {
+		t.Errorf("FromFloat32Slice(ToFloat32Slice()) = %v, %v, want %v", got, err, b)
+	}
+
+	// A rotation loses precision in float32 but stays within float32 epsilon.
+	rot := FromEuler([3]float64{0.3, -1.1, 2.2}, zerogdscript.EulerOrderYXZ)
+	got, err := FromFloat32Slice(rot.ToFloat32Slice())
+	if err != nil {
+		t.Fatalf("FromFloat32Slice: %v", err)
+	}
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if d := math.Abs(got.Rows[i][j] - rot.Rows[i][j]); d > 1e-7 {
+				t.Errorf("Rows[%d][%d] round trip off by %v", i, j, d)
+			}
+		}
+	}
 
-func TestBasis_Invert(t *testing.T) {}
+	for _, s := range [][]float32{nil, make([]float32, 8), make([]float32, 12)} {
+		if got, err := FromFloat32Slice(s); !errors.Is(err, zerogdscript.ErrInvalidLength) || got != New() {
+			t.Errorf("FromFloat32Slice(len %d) = %v, %v, want the identity and ErrInvalidLength", len(s), got, err)
+		}
+	}
+}
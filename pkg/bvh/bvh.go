@@ -0,0 +1,124 @@
+// Package bvh provides a bounding volume hierarchy over axis-aligned boxes
+// for fast ray and box queries against many objects.
+package bvh
+
+import (
+	"sort"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/aabb"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// maxLeafSize is the number of boxes at which a node stops splitting.
+const maxLeafSize = 4
+
+// BVH is a binary tree of bounding boxes built once over a fixed set of
+// boxes. Queries return indices into the slice passed to Build. A BVH is
+// read-only after Build and safe for concurrent queries.
+type BVH struct {
+	boxes []aabb.AABB
+	// order holds the box indices, permuted so each leaf owns a contiguous run.
+	order []int
+	nodes []node
+}
+
+type node struct {
+	box aabb.AABB
+	// For a leaf, order[start:start+count] are its boxes. For an inner node
+	// count is 0 and left and right index its children.
+	start, count int
+	left, right  int
+}
+
+// Build returns a BVH over boxes. Each node splits its boxes at the median
+// of their centres along the longest axis of the node. The boxes are copied,
+// so later changes to the slice do not affect the tree.
+func Build(boxes []aabb.AABB) *BVH {
+	b := &BVH{
+		boxes: append([]aabb.AABB(nil), boxes...),
+		order: make([]int, len(boxes)),
+	}
+	for i := range b.order {
+		b.order[i] = i
+	}
+	if len(boxes) > 0 {
+		b.build(0, len(boxes))
+	}
+	return b
+}
+
+// build adds the subtree over order[start:end] and returns its node index.
+func (b *BVH) build(start, end int) int {
+	idx := len(b.nodes)
+	b.nodes = append(b.nodes, node{})
+
+	bounds := b.boxes[b.order[start]]
+	centroidMin := bounds.GetCenter()
+	centroidMax := centroidMin
+	for _, i := range b.order[start+1 : end] {
+		bounds = bounds.Merge(b.boxes[i])
+		c := b.boxes[i].GetCenter()
+		centroidMin = centroidMin.Min(c)
+		centroidMax = centroidMax.Max(c)
+	}
+
+	if end-start <= maxLeafSize || centroidMin == centroidMax {
+		b.nodes[idx] = node{box: bounds, start: start, count: end - start}
+		return idx
+	}
+
+	axis := centroidMax.Sub(centroidMin).MaxAxisIndex()
+	run := b.order[start:end]
+	sort.Slice(run, func(i, j int) bool {
+		return b.boxes[run[i]].GetCenter().AsArray()[axis] < b.boxes[run[j]].GetCenter().AsArray()[axis]
+	})
+	mid := start + (end-start)/2
+	left := b.build(start, mid)
+	right := b.build(mid, end)
+	b.nodes[idx] = node{box: bounds, left: left, right: right}
+	return idx
+}
+
+// Raycast returns the indices, in ascending order, of the boxes hit by the
+// ray from origin along dir, as tested by aabb.AABB.IntersectsRay. These are
+// candidates: the objects inside the boxes still need an exact test.
+func (b *BVH) Raycast(origin, dir vector3.Vector3) []int {
+	return b.collect(func(box aabb.AABB) bool {
+		_, hit := box.IntersectsRay(origin, dir)
+		return hit
+	})
+}
+
+// Query returns the indices, in ascending order, of the boxes that intersect
+// box, as tested by aabb.AABB.Intersects.
+func (b *BVH) Query(box aabb.AABB) []int {
+	return b.collect(box.Intersects)
+}
+
+// collect walks the tree, descending into nodes whose bounds pass test, and
+// returns the sorted indices of the boxes that pass it too.
+func (b *BVH) collect(test func(aabb.AABB) bool) []int {
+	res := []int{}
+	if len(b.nodes) == 0 {
+		return res
+	}
+	stack := []int{0}
+	for len(stack) > 0 {
+		n := b.nodes[stack[len(stack)-1]]
+		stack = stack[:len(stack)-1]
+		if !test(n.box) {
+			continue
+		}
+		if n.count == 0 {
+			stack = append(stack, n.left, n.right)
+			continue
+		}
+		for _, i := range b.order[n.start : n.start+n.count] {
+			if test(b.boxes[i]) {
+				res = append(res, i)
+			}
+		}
+	}
+	sort.Ints(res)
+	return res
+}
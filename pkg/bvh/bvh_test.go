@@ -0,0 +1,114 @@
+package bvh
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/aabb"
+	"github.com/Anaxarchus/zero-gdscript/pkg/rng"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+func scatteredBoxes(n int) []aabb.AABB {
+	r := rng.New(3)
+	boxes := make([]aabb.AABB, n)
+	for i := range boxes {
+		pos := vector3.New(r.Randd()*100-50, r.Randd()*100-50, r.Randd()*100-50)
+		size := vector3.New(r.Randd()*6+0.1, r.Randd()*6+0.1, r.Randd()*6+0.1)
+		boxes[i] = aabb.New(pos, size)
+	}
+	return boxes
+}
+
+func TestBVH_Raycast(t *testing.T) {
+	boxes := scatteredBoxes(500)
+	tree := Build(boxes)
+
+	rays := [][2]vector3.Vector3{
+		{vector3.New(-60, 0, 0), vector3.New(1, 0, 0)},
+		{vector3.New(0, 0, 0), vector3.New(0, 1, 0)},
+		{vector3.New(-60, -60, -60), vector3.New(1, 1, 1)},
+		{vector3.New(10, -5, 60), vector3.New(-0.2, 0.1, -1)},
+		{vector3.New(0, 0, 0), vector3.New(0, 0, 0)},
+		{vector3.New(100, 100, 100), vector3.New(1, 0, 0)},
+	}
+	r := rng.New(9)
+	for i := 0; i < 30; i++ {
+		rays = append(rays, [2]vector3.Vector3{
+			vector3.New(r.Randd()*120-60, r.Randd()*120-60, r.Randd()*120-60),
+			vector3.New(r.Randd()*2-1, r.Randd()*2-1, r.Randd()*2-1),
+		})
+	}
+
+	hits := 0
+	for _, ray := range rays {
+		want := []int{}
+		for i, box := range boxes {
+			if _, hit := box.IntersectsRay(ray[0], ray[1]); hit {
+				want = append(want, i)
+			}
+		}
+		got := tree.Raycast(ray[0], ray[1])
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("Raycast(%v, %v) = %v, want %v", ray[0], ray[1], got, want)
+		}
+		hits += len(want)
+	}
+	if hits == 0 {
+		t.Fatalf("no ray hit any box; the test is not exercising anything")
+	}
+}
+
+func TestBVH_Query(t *testing.T) {
+	boxes := scatteredBoxes(500)
+	tree := Build(boxes)
+
+	queries := []aabb.AABB{
+		aabb.New(vector3.New(-10, -10, -10), vector3.New(20, 20, 20)),
+		aabb.New(vector3.New(-60, -60, -60), vector3.New(120, 120, 120)),
+		aabb.New(vector3.New(40, 40, 40), vector3.New(1, 1, 1)),
+		aabb.New(vector3.New(200, 0, 0), vector3.New(1, 1, 1)),
+	}
+	for i := 0; i < 10; i++ {
+		queries = append(queries, boxes[i*37])
+	}
+	for _, q := range queries {
+		want := []int{}
+		for i, box := range boxes {
+			if box.Intersects(q) {
+				want = append(want, i)
+			}
+		}
+		if got := tree.Query(q); !reflect.DeepEqual(got, want) {
+			t.Errorf("Query(%v) = %v, want %v", q, got, want)
+		}
+	}
+}
+
+func TestBVH_Build(t *testing.T) {
+	empty := Build(nil)
+	if got := empty.Raycast(vector3.Zero(), vector3.New(1, 0, 0)); len(got) != 0 {
+		t.Errorf("empty Raycast = %v, want none", got)
+	}
+	if got := empty.Query(aabb.New(vector3.Zero(), vector3.New(1, 1, 1))); len(got) != 0 {
+		t.Errorf("empty Query = %v, want none", got)
+	}
+
+	// Identical boxes cannot be split and end up in one leaf.
+	same := make([]aabb.AABB, 10)
+	for i := range same {
+		same[i] = aabb.New(vector3.Zero(), vector3.New(1, 1, 1))
+	}
+	if got := Build(same).Raycast(vector3.New(-1, 0.5, 0.5), vector3.New(1, 0, 0)); len(got) != 10 {
+		t.Errorf("Raycast over identical boxes = %v, want all 10", got)
+	}
+
+	// The tree keeps its own copy of the boxes.
+	boxes := scatteredBoxes(20)
+	tree := Build(boxes)
+	want := tree.Query(boxes[0])
+	boxes[0] = aabb.New(vector3.New(1000, 1000, 1000), vector3.New(1, 1, 1))
+	if got := tree.Query(tree.boxes[0]); !reflect.DeepEqual(got, want) {
+		t.Errorf("Query after changing the input = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,56 @@
+// Package camera provides helpers for orienting 3D cameras.
+package camera
+
+import (
+	"math"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
+	"github.com/Anaxarchus/zero-gdscript/pkg/quaternion"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// DefaultPitchLimit is the pitch limit NewLook uses, 89 degrees in radians.
+// Stopping short of straight up or down keeps the view from flipping over.
+const DefaultPitchLimit = 89 * math.Pi / 180
+
+// Look is a first-person look controller. The orientation is a yaw around
+// the world +Y axis followed by a pitch around the camera's local +X axis,
+// so there is no roll. With both angles at zero the camera looks down -Z;
+// a positive yaw turns left and a positive pitch looks up.
+type Look struct {
+	// Yaw is the heading in radians, kept in [-PI, PI).
+	Yaw float64
+	// Pitch is the elevation in radians, kept in [MinPitch, MaxPitch].
+	Pitch float64
+
+	// MinPitch and MaxPitch limit Pitch. MinPitch must not exceed MaxPitch.
+	MinPitch, MaxPitch float64
+}
+
+// NewLook returns a controller looking down -Z with the pitch limited to
+// plus or minus DefaultPitchLimit.
+func NewLook() *Look {
+	return &Look{MinPitch: -DefaultPitchLimit, MaxPitch: DefaultPitchLimit}
+}
+
+// Rotate adds dx to the yaw and dy to the pitch, both in radians. The yaw
+// wraps around and the pitch is clamped to its limits. Callers scale mouse
+// or stick input by their own sensitivity first.
+func (l *Look) Rotate(dx, dy float64) {
+	l.Yaw = zerogdscript.Wrapf(l.Yaw+dx, -math.Pi, math.Pi)
+	l.Pitch = zerogdscript.Clampf(l.Pitch+dy, l.MinPitch, l.MaxPitch)
+}
+
+// GetQuaternion returns the orientation as a unit quaternion.
+func (l Look) GetQuaternion() quaternion.Quaternion {
+	yaw := quaternion.Rotated(vector3.New(0, 1, 0), l.Yaw)
+	pitch := quaternion.Rotated(vector3.New(1, 0, 0), l.Pitch)
+	return yaw.Mul(pitch)
+}
+
+// GetBasis returns the orientation as a rotation basis. Its -Z column is the
+// view direction and its Y column the camera's up.
+func (l Look) GetBasis() basis.Basis {
+	return l.GetQuaternion().ToBasis()
+}
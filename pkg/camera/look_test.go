@@ -0,0 +1,106 @@
+package camera
+
+import (
+	"math"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+func TestLook_RotatePitchClamp(t *testing.T) {
+	limit := zerogdscript.DegToRad(89)
+	l := NewLook()
+	for i := 0; i < 100; i++ {
+		l.Rotate(0, 0.1)
+	}
+	if !zerogdscript.IsEqualApprox(l.Pitch, limit) {
+		t.Errorf("pitch after looking up = %v, want %v", l.Pitch, limit)
+	}
+	l.Rotate(0, -10)
+	if !zerogdscript.IsEqualApprox(l.Pitch, -limit) {
+		t.Errorf("pitch after looking down = %v, want %v", l.Pitch, -limit)
+	}
+
+	// Custom limits are honoured.
+	l = &Look{MinPitch: -0.5, MaxPitch: 0.25}
+	l.Rotate(0, 1)
+	if l.Pitch != 0.25 {
+		t.Errorf("pitch with a 0.25 limit = %v, want 0.25", l.Pitch)
+	}
+
+	// At the limit the view still points forward, not back over the top.
+	l = NewLook()
+	l.Rotate(0, math.Pi)
+	forward := l.GetQuaternion().Xform(vector3.New(0, 0, -1))
+	if forward.Z >= 0 || forward.Y <= 0.99 {
+		t.Errorf("forward at the pitch limit = %v, want nearly up and still towards -Z", forward)
+	}
+}
+
+func TestLook_RotateYawWrap(t *testing.T) {
+	l := NewLook()
+	for i := 0; i < 9; i++ {
+		l.Rotate(math.Pi/2, 0)
+	}
+	// Nine quarter turns are two full turns plus a quarter.
+	if !zerogdscript.IsEqualApprox(l.Yaw, math.Pi/2) {
+		t.Errorf("yaw after nine quarter turns = %v, want %v", l.Yaw, math.Pi/2)
+	}
+	l.Rotate(-3*math.Pi, 0)
+	if !zerogdscript.IsEqualApprox(l.Yaw, -math.Pi/2) {
+		t.Errorf("yaw after turning back = %v, want %v", l.Yaw, -math.Pi/2)
+	}
+	for i := 0; i < 1000; i++ {
+		l.Rotate(0.37, 0)
+		if l.Yaw < -math.Pi || l.Yaw >= math.Pi {
+			t.Fatalf("yaw %v left [-PI, PI)", l.Yaw)
+		}
+	}
+}
+
+func TestLook_GetBasis(t *testing.T) {
+	for _, tt := range []struct {
+		yaw, pitch float64
+		forward    vector3.Vector3
+	}{
+		{0, 0, vector3.New(0, 0, -1)},
+		{math.Pi / 2, 0, vector3.New(-1, 0, 0)},
+		{-math.Pi / 2, 0, vector3.New(1, 0, 0)},
+		{0, math.Pi / 4, vector3.New(0, math.Sqrt2/2, -math.Sqrt2/2)},
+		{math.Pi / 2, -math.Pi / 4, vector3.New(-math.Sqrt2/2, -math.Sqrt2/2, 0)},
+	} {
+		l := NewLook()
+		l.Rotate(tt.yaw, tt.pitch)
+		b := l.GetBasis()
+
+		forward := vector3.New(-b.Rows[0][2], -b.Rows[1][2], -b.Rows[2][2])
+		if !forward.IsEqualApprox(tt.forward) {
+			t.Errorf("yaw %v pitch %v: forward = %v, want %v", tt.yaw, tt.pitch, forward, tt.forward)
+		}
+		// No roll: the right axis stays horizontal.
+		if !zerogdscript.IsZeroApprox(b.Rows[1][0]) {
+			t.Errorf("yaw %v pitch %v: right axis has a vertical component %v", tt.yaw, tt.pitch, b.Rows[1][0])
+		}
+		// The basis is orthonormal with determinant 1.
+		for i := 0; i < 3; i++ {
+			for j := 0; j < 3; j++ {
+				d := b.Rows[0][i]*b.Rows[0][j] + b.Rows[1][i]*b.Rows[1][j] + b.Rows[2][i]*b.Rows[2][j]
+				want := 0.0
+				if i == j {
+					want = 1
+				}
+				if !zerogdscript.IsEqualApprox(d, want) {
+					t.Errorf("yaw %v pitch %v: column %d . column %d = %v, want %v", tt.yaw, tt.pitch, i, j, d, want)
+				}
+			}
+		}
+		if det := b.Determinant(); !zerogdscript.IsEqualApprox(det, 1) {
+			t.Errorf("yaw %v pitch %v: determinant = %v, want 1", tt.yaw, tt.pitch, det)
+		}
+		// The quaternion and the basis agree.
+		if got := l.GetQuaternion().Xform(vector3.New(0, 0, -1)); !got.IsEqualApprox(forward) {
+			t.Errorf("yaw %v pitch %v: quaternion forward = %v, basis forward %v", tt.yaw, tt.pitch, got, forward)
+		}
+	}
+}
@@ -0,0 +1,86 @@
+package color
+
+/**************************************************************************/
+/*  color.h                                                               */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import zerogdscript "github.com/Anaxarchus/zero-gdscript"
+
+// Color is a linear RGBA color with float components, nominally in [0, 1].
+type Color struct {
+	R float64 `json:"r"`
+	G float64 `json:"g"`
+	B float64 `json:"b"`
+	A float64 `json:"a"`
+}
+
+func New(r, g, b, a float64) Color {
+	return Color{R: r, G: g, B: b, A: a}
+}
+
+func (c Color) Add(b Color) Color {
+	c.R += b.R
+	c.G += b.G
+	c.B += b.B
+	c.A += b.A
+	return c
+}
+
+func (c Color) Sub(b Color) Color {
+	c.R -= b.R
+	c.G -= b.G
+	c.B -= b.B
+	c.A -= b.A
+	return c
+}
+
+func (c Color) Mulf(s float64) Color {
+	c.R *= s
+	c.G *= s
+	c.B *= s
+	c.A *= s
+	return c
+}
+
+func (c Color) Lerp(to Color, weight float64) Color {
+	c.R = zerogdscript.Lerp(c.R, to.R, weight)
+	c.G = zerogdscript.Lerp(c.G, to.G, weight)
+	c.B = zerogdscript.Lerp(c.B, to.B, weight)
+	c.A = zerogdscript.Lerp(c.A, to.A, weight)
+	return c
+}
+
+func (c Color) IsEqualApprox(b Color) bool {
+	return zerogdscript.IsEqualApprox(c.R, b.R) && zerogdscript.IsEqualApprox(c.G, b.G) &&
+		zerogdscript.IsEqualApprox(c.B, b.B) && zerogdscript.IsEqualApprox(c.A, b.A)
+}
@@ -0,0 +1,16 @@
+package color
+
+import "testing"
+
+func TestColor_Lerp(t *testing.T) {
+	a, b := New(0, 0.5, 1, 1), New(1, 0.5, 0, 0)
+	if got, want := a.Lerp(b, 0.25), New(0.25, 0.5, 0.75, 0.75); !got.IsEqualApprox(want) {
+		t.Errorf("Lerp = %v, want %v", got, want)
+	}
+	if got, want := a.Add(b).Mulf(0.5), a.Lerp(b, 0.5); !got.IsEqualApprox(want) {
+		t.Errorf("Add(b).Mulf(0.5) = %v, want the midpoint %v", got, want)
+	}
+	if got := a.Sub(a); got != (Color{}) {
+		t.Errorf("Sub(self) = %v, want transparent black", got)
+	}
+}
@@ -0,0 +1,209 @@
+package curve2d
+
+/**************************************************************************/
+/*  curve.h                                                               */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"math"
+	"sort"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// DefaultSamples is the number of samples per segment used to measure arc
+// length when no length table has been baked.
+const DefaultSamples = 32
+
+// Point is a control point of a Curve2D. In and Out are the Bezier handles,
+// relative to Position.
+type Point struct {
+	Position vector2.Vector2
+	In       vector2.Vector2
+	Out      vector2.Vector2
+}
+
+// Curve2D is a chain of cubic Bezier segments through its points.
+type Curve2D struct {
+	points []Point
+
+	// lengths[i] is the arc length from the start to the global parameter
+	// i/samples, where each segment spans a parameter range of 1.
+	lengths []float64
+	samples int
+}
+
+// New returns an empty curve.
+func New() *Curve2D {
+	return &Curve2D{}
+}
+
+// AddPoint appends a point with the given handles. It discards a baked length table.
+func (c *Curve2D) AddPoint(position, in, out vector2.Vector2) {
+	c.points = append(c.points, Point{Position: position, In: in, Out: out})
+	c.lengths = nil
+}
+
+// PointCount returns the number of points.
+func (c *Curve2D) PointCount() int {
+	return len(c.points)
+}
+
+// GetPoint returns the point at index.
+func (c *Curve2D) GetPoint(index int) Point {
+	return c.points[index]
+}
+
+// Sample returns the position between the point at index and the next one,
+// at offset in [0, 1]. Out-of-range indices clamp to the first or last point.
+func (c *Curve2D) Sample(index int, offset float64) vector2.Vector2 {
+	pc := len(c.points)
+	if pc == 0 {
+		return vector2.Zero()
+	}
+	if index >= pc-1 {
+		return c.points[pc-1].Position
+	} else if index < 0 {
+		return c.points[0].Position
+	}
+
+	p0 := c.points[index].Position
+	p1 := p0.Add(c.points[index].Out)
+	p3 := c.points[index+1].Position
+	p2 := p3.Add(c.points[index+1].In)
+	return vector2.New(
+		zerogdscript.BezierInterpolate(p0.X, p1.X, p2.X, p3.X, offset),
+		zerogdscript.BezierInterpolate(p0.Y, p1.Y, p2.Y, p3.Y, offset),
+	)
+}
+
+// Samplef samples the curve at a global parameter, where the integer part
+// selects the segment and the fraction is the offset within it.
+func (c *Curve2D) Samplef(fofs float64) vector2.Vector2 {
+	index := math.Floor(fofs)
+	return c.Sample(int(index), fofs-index)
+}
+
+// BakeLengthTable precomputes the cumulative arc length at samples points per
+// segment, so GetLength and SampleByDistance become a lookup and a binary
+// search instead of integrating the curve on every call. The table is
+// discarded when a point is added. samples < 1 uses DefaultSamples.
+func (c *Curve2D) BakeLengthTable(samples int) {
+	if samples < 1 {
+		samples = DefaultSamples
+	}
+	segments := len(c.points) - 1
+	if segments < 1 {
+		c.lengths, c.samples = []float64{0}, samples
+		return
+	}
+
+	n := segments * samples
+	lengths := make([]float64, n+1)
+	prev := c.points[0].Position
+	for i := 1; i <= n; i++ {
+		p := c.Samplef(float64(i) / float64(samples))
+		lengths[i] = lengths[i-1] + p.DistanceTo(prev)
+		prev = p
+	}
+	c.lengths, c.samples = lengths, samples
+}
+
+// GetLength returns the arc length of the curve.
+func (c *Curve2D) GetLength() float64 {
+	if c.lengths != nil {
+		return c.lengths[len(c.lengths)-1]
+	}
+
+	length := 0.0
+	if len(c.points) < 2 {
+		return length
+	}
+	n := (len(c.points) - 1) * DefaultSamples
+	prev := c.points[0].Position
+	for i := 1; i <= n; i++ {
+		p := c.Samplef(float64(i) / DefaultSamples)
+		length += p.DistanceTo(prev)
+		prev = p
+	}
+	return length
+}
+
+// SampleByDistance returns the position at the given arc length from the
+// start, clamped to the ends of the curve. It uses the baked length table if
+// there is one and integrates the curve with DefaultSamples per segment
+// otherwise.
+func (c *Curve2D) SampleByDistance(distance float64) vector2.Vector2 {
+	if len(c.points) == 0 {
+		return vector2.Zero()
+	}
+	if distance <= 0 || len(c.points) == 1 {
+		return c.points[0].Position
+	}
+	if c.lengths != nil {
+		return c.sampleBaked(distance)
+	}
+
+	n := (len(c.points) - 1) * DefaultSamples
+	length := 0.0
+	prev := c.points[0].Position
+	for i := 1; i <= n; i++ {
+		p := c.Samplef(float64(i) / DefaultSamples)
+		step := p.DistanceTo(prev)
+		if length+step >= distance && step > 0 {
+			frac := (distance - length) / step
+			return c.Samplef((float64(i-1) + frac) / DefaultSamples)
+		}
+		length += step
+		prev = p
+	}
+	return c.points[len(c.points)-1].Position
+}
+
+func (c *Curve2D) sampleBaked(distance float64) vector2.Vector2 {
+	total := c.lengths[len(c.lengths)-1]
+	if distance >= total {
+		return c.points[len(c.points)-1].Position
+	}
+
+	i := sort.SearchFloat64s(c.lengths, distance)
+	if i == 0 {
+		return c.points[0].Position
+	}
+	frac := 0.0
+	if step := c.lengths[i] - c.lengths[i-1]; step > 0 {
+		frac = (distance - c.lengths[i-1]) / step
+	}
+	return c.Samplef((float64(i-1) + frac) / float64(c.samples))
+}
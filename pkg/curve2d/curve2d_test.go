@@ -0,0 +1,99 @@
+package curve2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// sCurve returns a curve with three points and curved handles.
+func sCurve() *Curve2D {
+	c := New()
+	c.AddPoint(vector2.New(0, 0), vector2.Zero(), vector2.New(40, 0))
+	c.AddPoint(vector2.New(100, 50), vector2.New(-40, -20), vector2.New(40, 20))
+	c.AddPoint(vector2.New(200, 0), vector2.New(-40, 0), vector2.Zero())
+	return c
+}
+
+func TestCurve2D_Sample(t *testing.T) {
+	c := sCurve()
+	if got := c.Sample(0, 0); !got.IsEqualApprox(vector2.New(0, 0)) {
+		t.Errorf("Sample(0, 0) = %v, want the first point", got)
+	}
+	if got := c.Sample(0, 1); !got.IsEqualApprox(vector2.New(100, 50)) {
+		t.Errorf("Sample(0, 1) = %v, want the second point", got)
+	}
+	if got := c.Sample(5, 0.5); !got.IsEqualApprox(vector2.New(200, 0)) {
+		t.Errorf("Sample(5, 0.5) = %v, want the last point", got)
+	}
+}
+
+func TestCurve2D_SampleByDistanceLine(t *testing.T) {
+	c := New()
+	c.AddPoint(vector2.New(0, 0), vector2.Zero(), vector2.Zero())
+	c.AddPoint(vector2.New(10, 0), vector2.Zero(), vector2.Zero())
+	c.BakeLengthTable(64)
+
+	// Zero handles give a straight but non-uniformly parametrized segment;
+	// sampling by distance must still be uniform.
+	for _, d := range []float64{0, 2.5, 5, 7.5, 10} {
+		if got := c.SampleByDistance(d); math.Abs(got.X-d) > 1e-3 || got.Y != 0 {
+			t.Errorf("SampleByDistance(%v) = %v, want (%v, 0)", d, got, d)
+		}
+	}
+	if got := c.GetLength(); math.Abs(got-10) > 1e-9 {
+		t.Errorf("GetLength() = %v, want 10", got)
+	}
+}
+
+func TestCurve2D_BakeLengthTable(t *testing.T) {
+	integrated := sCurve()
+	baked := sCurve()
+	baked.BakeLengthTable(DefaultSamples)
+	fine := sCurve()
+	fine.BakeLengthTable(256)
+
+	if a, b := integrated.GetLength(), baked.GetLength(); math.Abs(a-b) > 1e-9 {
+		t.Errorf("baked GetLength() = %v, integrated %v", b, a)
+	}
+
+	length := integrated.GetLength()
+	for i := 0; i <= 20; i++ {
+		d := length * float64(i) / 20
+		want := integrated.SampleByDistance(d)
+		if got := baked.SampleByDistance(d); got.DistanceTo(want) > 1e-9 {
+			t.Errorf("baked SampleByDistance(%v) = %v, integrated %v", d, got, want)
+		}
+		if got := fine.SampleByDistance(d); got.DistanceTo(want) > 0.05 {
+			t.Errorf("fine SampleByDistance(%v) = %v, integrated %v", d, got, want)
+		}
+	}
+
+	// Adding a point discards the table.
+	baked.AddPoint(vector2.New(300, 0), vector2.Zero(), vector2.Zero())
+	if got, want := baked.GetLength(), length+100; math.Abs(got-want) > 1e-6 {
+		t.Errorf("GetLength() after AddPoint = %v, want %v", got, want)
+	}
+}
+
+var benchPoint vector2.Vector2
+
+func BenchmarkCurve2D_SampleByDistanceIntegrated(b *testing.B) {
+	c := sCurve()
+	length := c.GetLength()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchPoint = c.SampleByDistance(length * float64(i%100) / 100)
+	}
+}
+
+func BenchmarkCurve2D_SampleByDistanceBaked(b *testing.B) {
+	c := sCurve()
+	c.BakeLengthTable(DefaultSamples)
+	length := c.GetLength()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		benchPoint = c.SampleByDistance(length * float64(i%100) / 100)
+	}
+}
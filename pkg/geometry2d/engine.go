@@ -0,0 +1,166 @@
+package geometry2d
+
+import (
+	"fmt"
+	"math"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	clipper "github.com/ctessum/go.clipper"
+)
+
+// PolygonEngine is the backend that the offset functions, RoundCorners,
+// Offsetter and the polygon boolean functions call through. An engine either
+// returns valid rings or an error wrapping zerogdscript.ErrInvalidGeometry; it
+// never returns corrupt geometry.
+//
+// Offset offsets path by delta. EndTypePolygon offsets a closed polygon, the
+// other end types offset an open or joined polyline. arcTolerance is the
+// maximum distance, in world units, between a round join and the true arc.
+//
+// Boolean combines the closed polygons a and b with op, reading each with the
+// even-odd rule. Outer rings of the result have positive area and holes
+// negative area. A polygon with fewer than three distinct vertices is empty.
+//
+// The returned rings belong to the caller.
+type PolygonEngine interface {
+	Offset(path []vector2.Vector2, delta float64, joinType JoinType, endType EndType, arcTolerance float64) ([][]vector2.Vector2, error)
+	Boolean(op BooleanOperation, a, b []vector2.Vector2) ([][]vector2.Vector2, error)
+}
+
+// engine is the backend used by the package-level functions.
+var engine PolygonEngine = ClipperEngine{}
+
+// SetPolygonEngine makes the package-level functions use e. A nil engine
+// restores the default ClipperEngine. It is not safe to call concurrently with
+// the functions it affects; set it once at startup.
+func SetPolygonEngine(e PolygonEngine) {
+	if e == nil {
+		e = ClipperEngine{}
+	}
+	engine = e
+}
+
+// GetPolygonEngine returns the backend used by the package-level functions.
+func GetPolygonEngine() PolygonEngine {
+	return engine
+}
+
+// maxCoordinate is the largest coordinate clipper can represent once scaled
+// to fixed point.
+const maxCoordinate = 0x3FFFFFFFFFFFFFFF / scaleFactor
+
+// ClipperEngine is the default PolygonEngine. It runs go.clipper in fixed
+// point and rejects input that would not survive the conversion.
+type ClipperEngine struct{}
+
+// Offset implements PolygonEngine.
+func (ClipperEngine) Offset(path []vector2.Vector2, delta float64, joinType JoinType, endType EndType, arcTolerance float64) ([][]vector2.Vector2, error) {
+	o := offsetterPool.Get().(*Offsetter)
+	res, err := ClipperEngine{}.offset(o, path, delta, joinType, endType, arcTolerance)
+	res = copyRings(res)
+	offsetterPool.Put(o)
+	return res, err
+}
+
+// offset is Offset without the final copy: the rings it returns live in o's
+// buffers.
+func (ClipperEngine) offset(o *Offsetter, path []vector2.Vector2, delta float64, joinType JoinType, endType EndType, arcTolerance float64) ([][]vector2.Vector2, error) {
+	if err := checkPath(path, maxCoordinate); err != nil {
+		return o.reset(), err
+	}
+	if math.IsNaN(delta) || math.Abs(delta) > maxCoordinate {
+		return o.reset(), fmt.Errorf("%w: offset %v out of range", zerogdscript.ErrInvalidGeometry, delta)
+	}
+	res := o.offset(path, delta, clipper.JoinType(joinType), clipper.EndType(endType), arcTolerance)
+
+	// No join reaches further than the miter limit, so anything outside the
+	// input's bounds grown by that much is corrupt.
+	lo, hi := bounds(path)
+	reach := math.Abs(delta)*4 + 2/scaleFactor
+	for i, ring := range res {
+		if len(ring) < 3 {
+			return o.reset(), fmt.Errorf("%w: ring %d has %d vertices", zerogdscript.ErrInvalidGeometry, i, len(ring))
+		}
+		for _, p := range ring {
+			if p.X < lo.X-reach || p.Y < lo.Y-reach || p.X > hi.X+reach || p.Y > hi.Y+reach {
+				return o.reset(), fmt.Errorf("%w: ring %d has stray vertex %v", zerogdscript.ErrInvalidGeometry, i, p)
+			}
+		}
+	}
+	return res, nil
+}
+
+// Boolean implements PolygonEngine.
+func (ClipperEngine) Boolean(op BooleanOperation, a, b []vector2.Vector2) ([][]vector2.Vector2, error) {
+	var clipType clipper.ClipType
+	switch op {
+	case BooleanOperationUnion:
+		clipType = clipper.CtUnion
+	case BooleanOperationDifference:
+		clipType = clipper.CtDifference
+	case BooleanOperationIntersection:
+		clipType = clipper.CtIntersection
+	default:
+		clipType = clipper.CtXor
+	}
+
+	c := clipper.NewClipper(0)
+	add := func(path []vector2.Vector2, polyType clipper.PolyType) error {
+		if err := checkPath(path, maxCoordinate); err != nil {
+			return err
+		}
+		fixed := make(clipper.Path, len(path))
+		for i, p := range path {
+			fixed[i] = toFixedPointPrecision(p.X, p.Y)
+		}
+		c.AddPath(fixed, polyType, true)
+		return nil
+	}
+	if err := add(a, clipper.PtSubject); err != nil {
+		return [][]vector2.Vector2{}, err
+	}
+	if err := add(b, clipper.PtClip); err != nil {
+		return [][]vector2.Vector2{}, err
+	}
+	solutions, ok := c.Execute1(clipType, clipper.PftEvenOdd, clipper.PftEvenOdd)
+	if !ok {
+		return [][]vector2.Vector2{}, fmt.Errorf("%w: clipper failed", zerogdscript.ErrInvalidGeometry)
+	}
+
+	res := make([][]vector2.Vector2, 0, len(solutions))
+	for i, solution := range solutions {
+		if len(solution) < 3 {
+			return [][]vector2.Vector2{}, fmt.Errorf("%w: ring %d has %d vertices", zerogdscript.ErrInvalidGeometry, i, len(solution))
+		}
+		ring := make([]vector2.Vector2, len(solution))
+		for j, pt := range solution {
+			ring[j] = toFloatingPointPrecision(pt)
+		}
+		res = append(res, ring)
+	}
+	return res, nil
+}
+
+// bounds returns the corners of the bounding box of path.
+func bounds(path []vector2.Vector2) (lo, hi vector2.Vector2) {
+	if len(path) == 0 {
+		return lo, hi
+	}
+	lo, hi = path[0], path[0]
+	for _, p := range path[1:] {
+		lo = vector2.New(math.Min(lo.X, p.X), math.Min(lo.Y, p.Y))
+		hi = vector2.New(math.Max(hi.X, p.X), math.Max(hi.Y, p.Y))
+	}
+	return lo, hi
+}
+
+// checkPath reports non-finite vertices and vertices beyond limit.
+func checkPath(path []vector2.Vector2, limit float64) error {
+	for i, p := range path {
+		if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.Abs(p.X) > limit || math.Abs(p.Y) > limit {
+			return fmt.Errorf("%w: vertex %d is %v", zerogdscript.ErrInvalidGeometry, i, p)
+		}
+	}
+	return nil
+}
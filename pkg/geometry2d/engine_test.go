@@ -0,0 +1,252 @@
+package geometry2d
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// forEachEngine runs f once per PolygonEngine, restoring the default after.
+func forEachEngine(t *testing.T, f func(t *testing.T)) {
+	engines := []struct {
+		name   string
+		engine PolygonEngine
+	}{
+		{"Clipper", ClipperEngine{}},
+		{"Float", FloatEngine{}},
+	}
+	defer SetPolygonEngine(nil)
+	for _, e := range engines {
+		SetPolygonEngine(e.engine)
+		t.Run(e.name, f)
+	}
+}
+
+// pathologicalPolygons are inputs that break naive offsetting.
+var pathologicalPolygons = map[string][]vector2.Vector2{
+	"spike": {
+		vector2.New(0, 0), vector2.New(10, 0), vector2.New(10, 4),
+		vector2.New(30, 5), vector2.New(10, 6), vector2.New(10, 10), vector2.New(0, 10),
+	},
+	"inward spike": {
+		vector2.New(0, 0), vector2.New(10, 0), vector2.New(10, 4.9),
+		vector2.New(1, 5), vector2.New(10, 5.1), vector2.New(10, 10), vector2.New(0, 10),
+	},
+	"near collinear": {
+		vector2.New(0, 0), vector2.New(5, 1e-9), vector2.New(10, 0),
+		vector2.New(10, 10), vector2.New(5, 10+1e-9), vector2.New(0, 10),
+	},
+	"duplicate vertices": {
+		vector2.New(0, 0), vector2.New(0, 0), vector2.New(10, 0),
+		vector2.New(10, 10), vector2.New(10, 10), vector2.New(0, 10), vector2.New(0, 0),
+	},
+	"self touching": {
+		vector2.New(0, 0), vector2.New(10, 0), vector2.New(10, 10), vector2.New(5, 0.5),
+		vector2.New(5, 0), vector2.New(4, 10), vector2.New(0, 10),
+	},
+	"bow tie": {
+		vector2.New(0, 0), vector2.New(10, 10), vector2.New(10, 0), vector2.New(0, 10),
+	},
+	"degenerate": {
+		vector2.New(0, 0), vector2.New(5, 0), vector2.New(10, 0),
+	},
+}
+
+func TestPolygonEngine_Pathological(t *testing.T) {
+	joins := []JoinType{JoinTypeSquare, JoinTypeRound, JoinTypeMiter}
+	forEachEngine(t, func(t *testing.T) {
+		for name, polygon := range pathologicalPolygons {
+			for _, delta := range []float64{-3, -0.5, 0.5, 3} {
+				for _, jt := range joins {
+					rings, err := OffsetPolygonChecked(polygon, delta, jt)
+					if err != nil {
+						if !errors.Is(err, zerogdscript.ErrInvalidGeometry) {
+							t.Errorf("%s delta %v join %v: error %v does not wrap ErrInvalidGeometry", name, delta, jt, err)
+						}
+						if len(rings) != 0 {
+							t.Errorf("%s delta %v join %v: returned %d rings with an error", name, delta, jt, len(rings))
+						}
+						continue
+					}
+					for i, ring := range rings {
+						if len(ring) < 3 {
+							t.Errorf("%s delta %v join %v: ring %d has %d vertices", name, delta, jt, i, len(ring))
+						}
+						for _, p := range ring {
+							if math.IsNaN(p.X) || math.IsNaN(p.Y) || math.Abs(p.X) > 100 || math.Abs(p.Y) > 100 {
+								t.Errorf("%s delta %v join %v: ring %d has corrupt vertex %v", name, delta, jt, i, p)
+								break
+							}
+						}
+					}
+				}
+			}
+		}
+	})
+}
+
+func TestPolygonEngine_InvalidInput(t *testing.T) {
+	forEachEngine(t, func(t *testing.T) {
+		for _, bad := range []vector2.Vector2{
+			vector2.New(math.NaN(), 0),
+			vector2.New(0, math.Inf(1)),
+		} {
+			polygon := []vector2.Vector2{vector2.New(0, 0), vector2.New(10, 0), bad}
+			if _, err := OffsetPolygonChecked(polygon, 1, JoinTypeSquare); !errors.Is(err, zerogdscript.ErrInvalidGeometry) {
+				t.Errorf("OffsetPolygonChecked() with %v: err = %v, want ErrInvalidGeometry", bad, err)
+			}
+			if res := OffsetPolygon(polygon, 1, JoinTypeSquare); len(res) != 0 {
+				t.Errorf("OffsetPolygon() with %v returned %d rings, want 0", bad, len(res))
+			}
+		}
+
+		square := []vector2.Vector2{vector2.New(0, 0), vector2.New(1, 0), vector2.New(1, 1), vector2.New(0, 1)}
+		polygon := []vector2.Vector2{vector2.New(0, 0), vector2.New(10, 0), vector2.New(math.NaN(), 5)}
+		if _, err := MergePolygonsChecked(square, polygon); !errors.Is(err, zerogdscript.ErrInvalidGeometry) {
+			t.Errorf("MergePolygonsChecked() with NaN: err = %v, want ErrInvalidGeometry", err)
+		}
+		if res := IntersectPolygons(polygon, square); len(res) != 0 {
+			t.Errorf("IntersectPolygons() with NaN returned %d rings, want 0", len(res))
+		}
+		if _, err := OffsetPolylineChecked(square, math.NaN(), JoinTypeSquare, EndTypeButt); !errors.Is(err, zerogdscript.ErrInvalidGeometry) {
+			t.Errorf("OffsetPolylineChecked() with NaN delta: err = %v, want ErrInvalidGeometry", err)
+		}
+	})
+}
+
+// totalArea sums the signed areas of rings, so holes subtract.
+func totalArea(rings [][]vector2.Vector2) float64 {
+	area := 0.0
+	for _, ring := range rings {
+		area += polygonArea(ring)
+	}
+	return area
+}
+
+func TestPolygonEngine_Boolean(t *testing.T) {
+	square := func(x, y, size float64) []vector2.Vector2 {
+		return []vector2.Vector2{vector2.New(x, y), vector2.New(x+size, y), vector2.New(x+size, y+size), vector2.New(x, y+size)}
+	}
+	a, b := square(0, 0, 10), square(5, 5, 10)
+	forEachEngine(t, func(t *testing.T) {
+		tests := []struct {
+			name  string
+			fn    func(a, b []vector2.Vector2) ([][]vector2.Vector2, error)
+			a, b  []vector2.Vector2
+			rings int
+			area  float64
+		}{
+			{"merge", MergePolygonsChecked, a, b, 1, 175},
+			{"clip", ClipPolygonsChecked, a, b, 1, 75},
+			{"intersect", IntersectPolygonsChecked, a, b, 1, 25},
+			{"exclude", ExcludePolygonsChecked, a, b, 2, 150},
+			{"clip hole", ClipPolygonsChecked, a, square(3, 3, 4), 2, 84},
+			{"merge disjoint", MergePolygonsChecked, a, square(20, 0, 1), 2, 101},
+			{"intersect disjoint", IntersectPolygonsChecked, a, square(20, 0, 1), 0, 0},
+			{"merge degenerate", MergePolygonsChecked, a, square(20, 0, 0), 1, 100},
+			{"clip by itself", ClipPolygonsChecked, a, a, 0, 0},
+		}
+		for _, tt := range tests {
+			rings, err := tt.fn(tt.a, tt.b)
+			if err != nil {
+				t.Errorf("%s: %v", tt.name, err)
+				continue
+			}
+			if len(rings) != tt.rings {
+				t.Errorf("%s: got %d rings, want %d", tt.name, len(rings), tt.rings)
+			}
+			if area := totalArea(rings); math.Abs(area-tt.area) > 1e-6 {
+				t.Errorf("%s: area = %v, want %v", tt.name, area, tt.area)
+			}
+		}
+
+		rings := ClipPolygons(a, square(3, 3, 4))
+		holes := 0
+		for _, ring := range rings {
+			if IsPolygonClockwise(ring) {
+				holes++
+			}
+		}
+		if holes != 1 {
+			t.Errorf("ClipPolygons() of a square with a hole = %v, want one clockwise hole", rings)
+		}
+
+		reversed := []vector2.Vector2{b[3], b[2], b[1], b[0]}
+		if area := totalArea(MergePolygons(a, reversed)); math.Abs(area-175) > 1e-6 {
+			t.Errorf("MergePolygons() with a clockwise polygon: area = %v, want 175", area)
+		}
+	})
+}
+
+func TestPolygonEngine_BooleanPathological(t *testing.T) {
+	clip := []vector2.Vector2{vector2.New(2, 2), vector2.New(8, 3), vector2.New(7, 12), vector2.New(3, 8)}
+	forEachEngine(t, func(t *testing.T) {
+		for name, polygon := range pathologicalPolygons {
+			areas := map[BooleanOperation]float64{}
+			for _, op := range []BooleanOperation{BooleanOperationUnion, BooleanOperationDifference, BooleanOperationIntersection, BooleanOperationXor} {
+				rings, err := GetPolygonEngine().Boolean(op, polygon, clip)
+				if err != nil {
+					if !errors.Is(err, zerogdscript.ErrInvalidGeometry) {
+						t.Errorf("%s op %v: error %v does not wrap ErrInvalidGeometry", name, op, err)
+					}
+					if len(rings) != 0 {
+						t.Errorf("%s op %v: returned %d rings with an error", name, op, len(rings))
+					}
+					continue
+				}
+				for i, ring := range rings {
+					if err := checkRing(ring); err != nil {
+						t.Errorf("%s op %v: ring %d %v", name, op, i, err)
+					}
+				}
+				areas[op] = totalArea(rings)
+			}
+			if len(areas) < 4 {
+				continue
+			}
+			// The union is the intersection plus what only one polygon covers.
+			if diff := areas[BooleanOperationUnion] - areas[BooleanOperationIntersection] - areas[BooleanOperationXor]; math.Abs(diff) > 1e-6 {
+				t.Errorf("%s: union - intersection - xor = %v, want 0", name, diff)
+			}
+		}
+	})
+}
+
+func TestFloatEngine_Offset(t *testing.T) {
+	square := []vector2.Vector2{vector2.New(0, 0), vector2.New(10, 0), vector2.New(10, 10), vector2.New(0, 10)}
+
+	rings, err := FloatEngine{}.Offset(square, 1, JoinTypeMiter, EndTypePolygon, arcTolerance)
+	if err != nil || len(rings) != 1 {
+		t.Fatalf("Offset() = %v, %v, want one ring", rings, err)
+	}
+	if area := polygonArea(rings[0]); math.Abs(area-144) > 1e-9 {
+		t.Errorf("mitered outset area = %v, want 144", area)
+	}
+
+	rings, err = FloatEngine{}.Offset(square, -6, JoinTypeMiter, EndTypePolygon, arcTolerance)
+	if err != nil || len(rings) != 0 {
+		t.Errorf("Offset() past the center = %v, %v, want no rings and no error", rings, err)
+	}
+
+	rings, err = FloatEngine{}.Offset(square, 1, JoinTypeMiter, EndTypeJoined, arcTolerance)
+	if err != nil || len(rings) != 2 {
+		t.Fatalf("joined Offset() = %v, %v, want two rings", rings, err)
+	}
+	if area := polygonArea(rings[0]) + polygonArea(rings[1]); math.Abs(area-(144-64)) > 1e-9 {
+		t.Errorf("joined outline area = %v, want 80", area)
+	}
+}
+
+func TestGeometry2D_SetPolygonEngine(t *testing.T) {
+	SetPolygonEngine(FloatEngine{})
+	if _, ok := GetPolygonEngine().(FloatEngine); !ok {
+		t.Errorf("GetPolygonEngine() = %T, want FloatEngine", GetPolygonEngine())
+	}
+	SetPolygonEngine(nil)
+	if _, ok := GetPolygonEngine().(ClipperEngine); !ok {
+		t.Errorf("GetPolygonEngine() after SetPolygonEngine(nil) = %T, want ClipperEngine", GetPolygonEngine())
+	}
+}
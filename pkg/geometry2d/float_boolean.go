@@ -0,0 +1,309 @@
+package geometry2d
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// Boolean implements PolygonEngine. It overlays the two polygons directly in
+// float64: every edge is split where it meets another, a piece is kept when
+// the result is filled on exactly one side of it, and the kept pieces are
+// linked into rings. Rings that touch themselves are split at the touching
+// vertex. Input whose pieces are too close together to tell their sides apart
+// returns an error wrapping zerogdscript.ErrInvalidGeometry. The work is
+// quadratic in the number of edges.
+func (e FloatEngine) Boolean(op BooleanOperation, a, b []vector2.Vector2) ([][]vector2.Vector2, error) {
+	for _, path := range [][]vector2.Vector2{a, b} {
+		if err := checkPath(path, math.MaxFloat64); err != nil {
+			return [][]vector2.Vector2{}, err
+		}
+	}
+	a, b = dedupe(a, true), dedupe(b, true)
+	if len(a) < 3 {
+		a = nil
+	}
+	if len(b) < 3 {
+		b = nil
+	}
+
+	scale := 0.0
+	for _, p := range append(append([]vector2.Vector2{}, a...), b...) {
+		scale = math.Max(scale, math.Max(math.Abs(p.X), math.Abs(p.Y)))
+	}
+	ov := overlay{eps: scale * 1e-10}
+	ov.split(a, b)
+
+	filled := func(p vector2.Vector2) bool {
+		inA, inB := pointInPolygonEvenOdd(p, a), pointInPolygonEvenOdd(p, b)
+		switch op {
+		case BooleanOperationUnion:
+			return inA || inB
+		case BooleanOperationDifference:
+			return inA && !inB
+		case BooleanOperationIntersection:
+			return inA && inB
+		default:
+			return inA != inB
+		}
+	}
+	if err := ov.classify(filled); err != nil {
+		return [][]vector2.Vector2{}, fmt.Errorf("%w: %v", zerogdscript.ErrInvalidGeometry, err)
+	}
+	rings, err := ov.link()
+	if err != nil {
+		return [][]vector2.Vector2{}, fmt.Errorf("%w: %v", zerogdscript.ErrInvalidGeometry, err)
+	}
+	for i, ring := range rings {
+		if err := checkRing(ring); err != nil {
+			return [][]vector2.Vector2{}, fmt.Errorf("%w: ring %d %v", zerogdscript.ErrInvalidGeometry, i, err)
+		}
+	}
+	return rings, nil
+}
+
+// overlay is the planar arrangement of two polygons' edges that
+// FloatEngine.Boolean works on.
+type overlay struct {
+	eps   float64
+	verts []vector2.Vector2
+	// edges are the undirected pieces left after splitting, as vertex indices.
+	edges [][2]int
+	// kept are the pieces on the result's boundary, directed with the
+	// result on their left.
+	kept [][2]int
+}
+
+// vertex returns the index of the vertex within eps of p, adding p if there
+// is none.
+func (ov *overlay) vertex(p vector2.Vector2) int {
+	for i, v := range ov.verts {
+		if math.Abs(v.X-p.X) <= ov.eps && math.Abs(v.Y-p.Y) <= ov.eps {
+			return i
+		}
+	}
+	ov.verts = append(ov.verts, p)
+	return len(ov.verts) - 1
+}
+
+// split cuts the edges of both polygons wherever they cross or touch each
+// other and keeps one copy of each resulting piece.
+func (ov *overlay) split(polygons ...[]vector2.Vector2) {
+	var segments [][2]vector2.Vector2
+	for _, poly := range polygons {
+		for i := range poly {
+			segments = append(segments, [2]vector2.Vector2{poly[i], poly[(i+1)%len(poly)]})
+		}
+	}
+	cuts := make([][]float64, len(segments))
+	for i := range segments {
+		cuts[i] = []float64{0, 1}
+	}
+	for i, s := range segments {
+		for j := i + 1; j < len(segments); j++ {
+			t := segments[j]
+			// Endpoints that touch the other segment, which also covers
+			// collinear overlaps.
+			for k := 0; k < 2; k++ {
+				if u, ok := ov.touch(t[k], s); ok {
+					cuts[i] = append(cuts[i], u)
+				}
+				if u, ok := ov.touch(s[k], t); ok {
+					cuts[j] = append(cuts[j], u)
+				}
+			}
+			// Proper crossings.
+			r, q := s[1].Sub(s[0]), t[1].Sub(t[0])
+			denom := r.Cross(q)
+			if denom == 0 {
+				continue
+			}
+			d := t[0].Sub(s[0])
+			u, v := d.Cross(q)/denom, d.Cross(r)/denom
+			if u > 0 && u < 1 && v > 0 && v < 1 {
+				cuts[i] = append(cuts[i], u)
+				cuts[j] = append(cuts[j], v)
+			}
+		}
+	}
+
+	seen := map[[2]int]bool{}
+	for i, s := range segments {
+		ts := cuts[i]
+		sort.Float64s(ts)
+		prev := ov.vertex(s[0])
+		for _, u := range ts[1:] {
+			var cur int
+			if u == 1 {
+				cur = ov.vertex(s[1])
+			} else {
+				cur = ov.vertex(s[0].Lerp(s[1], u))
+			}
+			if cur == prev {
+				continue
+			}
+			key := [2]int{min(prev, cur), max(prev, cur)}
+			if !seen[key] {
+				seen[key] = true
+				ov.edges = append(ov.edges, key)
+			}
+			prev = cur
+		}
+	}
+}
+
+// touch reports whether p lies within eps of the inside of segment s, and
+// where along s it does.
+func (ov *overlay) touch(p vector2.Vector2, s [2]vector2.Vector2) (float64, bool) {
+	r := s[1].Sub(s[0])
+	l2 := r.LengthSquared()
+	if l2 == 0 {
+		return 0, false
+	}
+	u := p.Sub(s[0]).Dot(r) / l2
+	if u <= 0 || u >= 1 {
+		return 0, false
+	}
+	if s[0].Lerp(s[1], u).DistanceTo(p) > ov.eps {
+		return 0, false
+	}
+	return u, true
+}
+
+// classify keeps the pieces whose two sides differ under filled, directed so
+// the filled side is on their left. Each side is sampled halfway between the
+// piece's midpoint and the nearest other piece, so no edge lies in between.
+func (ov *overlay) classify(filled func(vector2.Vector2) bool) error {
+	for i, e := range ov.edges {
+		p, q := ov.verts[e[0]], ov.verts[e[1]]
+		m := p.Lerp(q, 0.5)
+		clearance := p.DistanceTo(q) / 2
+		for j, f := range ov.edges {
+			if j != i {
+				clearance = math.Min(clearance, GetDistanceToSegment(m, [2]vector2.Vector2{ov.verts[f[0]], ov.verts[f[1]]}))
+			}
+		}
+		if clearance <= ov.eps {
+			return fmt.Errorf("edges meet within %v of %v", ov.eps, m)
+		}
+		d := q.Sub(p).Normalized()
+		n := vector2.New(-d.Y, d.X).Mulf(clearance / 2)
+		left, right := filled(m.Add(n)), filled(m.Sub(n))
+		switch {
+		case left && !right:
+			ov.kept = append(ov.kept, e)
+		case right && !left:
+			ov.kept = append(ov.kept, [2]int{e[1], e[0]})
+		}
+	}
+	return nil
+}
+
+// link joins the kept pieces into rings. Where several continue from one
+// vertex it takes the sharpest left turn, then splits rings that pass through
+// a vertex twice and drops vertices that lie on a straight run.
+func (ov *overlay) link() ([][]vector2.Vector2, error) {
+	out := map[int][]int{}
+	for i, e := range ov.kept {
+		out[e[0]] = append(out[e[0]], i)
+	}
+	used := make([]bool, len(ov.kept))
+	rings := [][]vector2.Vector2{}
+	for start := range ov.kept {
+		if used[start] {
+			continue
+		}
+		used[start] = true
+		loop := []int{ov.kept[start][0]}
+		cur := start
+		for {
+			from, at := ov.kept[cur][0], ov.kept[cur][1]
+			back := ov.verts[from].Sub(ov.verts[at])
+			next, best := -1, math.Inf(1)
+			for _, c := range out[at] {
+				if used[c] && c != start {
+					continue
+				}
+				dir := ov.verts[ov.kept[c][1]].Sub(ov.verts[at])
+				// Clockwise angle from the way back to the candidate.
+				turn := -math.Atan2(back.Cross(dir), back.Dot(dir))
+				if turn <= 0 {
+					turn += 2 * math.Pi
+				}
+				if turn < best {
+					next, best = c, turn
+				}
+			}
+			if next == -1 {
+				return nil, fmt.Errorf("boundary ends at %v", ov.verts[at])
+			}
+			if next == start {
+				break
+			}
+			used[next] = true
+			loop = append(loop, at)
+			cur = next
+		}
+		for _, part := range splitLoop(loop) {
+			ring := make([]vector2.Vector2, len(part))
+			for i, v := range part {
+				ring[i] = ov.verts[v]
+			}
+			if ring = ov.dropStraight(ring); len(ring) >= 3 {
+				rings = append(rings, ring)
+			}
+		}
+	}
+	return rings, nil
+}
+
+// splitLoop splits a loop of vertex indices wherever it revisits a vertex.
+func splitLoop(loop []int) [][]int {
+	var parts [][]int
+	var stack []int
+	at := map[int]int{}
+	for _, v := range loop {
+		if k, ok := at[v]; ok {
+			parts = append(parts, append([]int{}, stack[k:]...))
+			for _, w := range stack[k+1:] {
+				delete(at, w)
+			}
+			stack = stack[:k+1]
+			continue
+		}
+		at[v] = len(stack)
+		stack = append(stack, v)
+	}
+	return append(parts, stack)
+}
+
+// dropStraight removes the vertices of ring that lie within eps of the line
+// through their neighbours.
+func (ov *overlay) dropStraight(ring []vector2.Vector2) []vector2.Vector2 {
+	for i := 0; len(ring) >= 3 && i < len(ring); {
+		prev, next := ring[(i+len(ring)-1)%len(ring)], ring[(i+1)%len(ring)]
+		if GetDistanceToSegment(ring[i], [2]vector2.Vector2{prev, next}) <= ov.eps {
+			ring = append(ring[:i], ring[i+1:]...)
+			i = max(i-1, 0)
+			continue
+		}
+		i++
+	}
+	return ring
+}
+
+// pointInPolygonEvenOdd reports whether p is inside polygon under the
+// even-odd rule. p is assumed not to lie on an edge.
+func pointInPolygonEvenOdd(p vector2.Vector2, polygon []vector2.Vector2) bool {
+	in := false
+	for i, j := 0, len(polygon)-1; i < len(polygon); j, i = i, i+1 {
+		a, b := polygon[i], polygon[j]
+		if (a.Y > p.Y) != (b.Y > p.Y) && p.X < (b.X-a.X)*(p.Y-a.Y)/(b.Y-a.Y)+a.X {
+			in = !in
+		}
+	}
+	return in
+}
@@ -0,0 +1,317 @@
+package geometry2d
+
+import (
+	"fmt"
+	"math"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// FloatEngine is a PolygonEngine that offsets in float64 directly, without
+// clipper's fixed-point scaling. It offsets each vertex with the same joins as
+// clipper but does not union the result, so it cannot resolve the
+// self-intersections that large insets, spikes or self-touching input
+// produce. Instead it checks the input and every ring it produces and returns
+// an error wrapping zerogdscript.ErrInvalidGeometry. The checks are quadratic
+// in the ring size.
+//
+// A closed polygon that shrinks away entirely returns no rings and no error.
+type FloatEngine struct {
+	// MiterLimit bounds miter joins as a multiple of delta. Values below 2
+	// use 2. The zero value uses 4, like the package-level functions.
+	MiterLimit float64
+}
+
+// Offset implements PolygonEngine.
+func (e FloatEngine) Offset(path []vector2.Vector2, delta float64, joinType JoinType, endType EndType, arcTolerance float64) ([][]vector2.Vector2, error) {
+	if err := checkPath(path, math.MaxFloat64); err != nil {
+		return [][]vector2.Vector2{}, err
+	}
+	if math.IsNaN(delta) || math.IsInf(delta, 0) {
+		return [][]vector2.Vector2{}, fmt.Errorf("%w: offset %v out of range", zerogdscript.ErrInvalidGeometry, delta)
+	}
+
+	closed := endType == EndTypePolygon || endType == EndTypeJoined
+	pts := dedupe(path, closed)
+	if closed && len(pts) >= 3 {
+		if err := checkRing(pts); err != nil {
+			return [][]vector2.Vector2{}, fmt.Errorf("%w: input %v", zerogdscript.ErrInvalidGeometry, err)
+		}
+	}
+	o := floatOffsetter{delta: delta, joinType: joinType, miterLimit: e.MiterLimit, arcTolerance: arcTolerance}
+	if o.miterLimit == 0 {
+		o.miterLimit = 4
+	}
+	if o.miterLimit < 2 {
+		o.miterLimit = 2
+	}
+
+	var rings [][]vector2.Vector2
+	switch endType {
+	case EndTypePolygon:
+		if len(pts) < 3 || delta == 0 {
+			if len(pts) >= 3 {
+				rings = append(rings, append([]vector2.Vector2{}, pts...))
+			}
+			break
+		}
+		if polygonArea(pts) < 0 {
+			reverse(pts)
+		}
+		ring := o.offsetLoop(pts, nil)
+		if delta < 0 && (polygonArea(ring) <= 0 || clearanceViolations(pts, true, ring, delta) == len(ring)) {
+			// Shrunk away entirely.
+			break
+		}
+		rings = append(rings, ring)
+	case EndTypeJoined:
+		if len(pts) < 3 || delta <= 0 {
+			break
+		}
+		if polygonArea(pts) < 0 {
+			reverse(pts)
+		}
+		outer := o.offsetLoop(pts, nil)
+		o.delta = -delta
+		inner := o.offsetLoop(pts, nil)
+		rings = append(rings, outer)
+		if polygonArea(inner) > 0 && clearanceViolations(pts, true, inner, delta) < len(inner) {
+			reverse(inner)
+			rings = append(rings, inner)
+		}
+	default:
+		if len(pts) < 2 || delta <= 0 {
+			break
+		}
+		// Walk the polyline forward and back as one loop with caps at both ends.
+		loop := append([]vector2.Vector2{}, pts...)
+		for i := len(pts) - 2; i >= 1; i-- {
+			loop = append(loop, pts[i])
+		}
+		ring := o.offsetLoop(loop, map[int]EndType{0: endType, len(pts) - 1: endType})
+		if polygonArea(ring) < 0 {
+			reverse(ring)
+		}
+		rings = append(rings, ring)
+	}
+
+	for i, ring := range rings {
+		err := checkRing(ring)
+		if err == nil && clearanceViolations(pts, closed, ring, delta) > 0 {
+			err = fmt.Errorf("comes closer than %v to the input", math.Abs(delta))
+		}
+		if err != nil {
+			return [][]vector2.Vector2{}, fmt.Errorf("%w: ring %d %v", zerogdscript.ErrInvalidGeometry, i, err)
+		}
+	}
+	if rings == nil {
+		rings = [][]vector2.Vector2{}
+	}
+	return rings, nil
+}
+
+// floatOffsetter holds the settings of one FloatEngine.Offset call.
+type floatOffsetter struct {
+	delta        float64
+	joinType     JoinType
+	miterLimit   float64
+	arcTolerance float64
+}
+
+// steps returns the number of segments a full circle of radius |delta| needs
+// to stay within the arc tolerance, the same way clipper computes it.
+func (o *floatOffsetter) steps() float64 {
+	d := math.Abs(o.delta)
+	y := o.arcTolerance
+	if y <= 0 {
+		y = arcTolerance
+	}
+	if y > d*0.25 {
+		y = d * 0.25
+	}
+	steps := math.Pi / math.Acos(1-y/d)
+	if steps > d*math.Pi {
+		steps = d * math.Pi
+	}
+	return math.Max(steps, 4)
+}
+
+// offsetLoop offsets a closed loop with positive area. caps marks the loop
+// indices that are the ends of an open polyline.
+func (o *floatOffsetter) offsetLoop(p []vector2.Vector2, caps map[int]EndType) []vector2.Vector2 {
+	d := o.delta
+	n := len(p)
+	out := make([]vector2.Vector2, 0, n)
+	for i := 0; i < n; i++ {
+		prev, cur, next := p[(i+n-1)%n], p[i], p[(i+1)%n]
+		n1 := edgeNormal(prev, cur)
+		n2 := edgeNormal(cur, next)
+
+		if et, ok := caps[i]; ok {
+			dir := vector2.New(-n1.Y, n1.X)
+			switch et {
+			case EndTypeButt:
+				out = append(out, cur.Add(n1.Mulf(d)), cur.Add(n2.Mulf(d)))
+			case EndTypeSquare:
+				out = append(out, cur.Add(n1.Add(dir).Mulf(d)), cur.Add(n2.Add(dir).Mulf(d)))
+			default:
+				out = o.appendArc(out, cur, n1, math.Pi)
+			}
+			continue
+		}
+
+		sinA := n1.Cross(n2)
+		cosA := n1.Dot(n2)
+		if math.Abs(sinA) < 1e-12 && cosA > 0 {
+			// Collinear.
+			out = append(out, cur.Add(n1.Mulf(d)))
+			continue
+		}
+		if sinA*d < 0 {
+			// The corner turns away from the offset: the offset edges cross.
+			out = append(out, cur.Add(n1.Add(n2).Mulf(d/(1+cosA))))
+			continue
+		}
+
+		switch o.joinType {
+		case JoinTypeRound:
+			out = o.appendArc(out, cur, n1, math.Atan2(sinA, cosA))
+		case JoinTypeMiter:
+			if r := 1 + cosA; r >= 2/(o.miterLimit*o.miterLimit) {
+				out = append(out, cur.Add(n1.Add(n2).Mulf(d/r)))
+			} else {
+				out = appendSquare(out, cur, n1, n2, sinA, cosA, d)
+			}
+		default:
+			out = appendSquare(out, cur, n1, n2, sinA, cosA, d)
+		}
+	}
+	return out
+}
+
+// appendArc appends an arc of radius |delta| around center, starting at
+// normal from and sweeping counterclockwise by sweep radians.
+func (o *floatOffsetter) appendArc(out []vector2.Vector2, center, from vector2.Vector2, sweep float64) []vector2.Vector2 {
+	cnt := int(math.Ceil(math.Abs(sweep) / (2 * math.Pi) * o.steps()))
+	if cnt < 1 {
+		cnt = 1
+	}
+	start := math.Atan2(from.Y, from.X)
+	for k := 0; k <= cnt; k++ {
+		a := start + sweep*float64(k)/float64(cnt)
+		out = append(out, center.Add(vector2.New(math.Cos(a), math.Sin(a)).Mulf(o.delta)))
+	}
+	return out
+}
+
+// appendSquare appends a square join, clipper's DoSquare.
+func appendSquare(out []vector2.Vector2, cur, n1, n2 vector2.Vector2, sinA, cosA, d float64) []vector2.Vector2 {
+	dx := math.Tan(math.Atan2(sinA, cosA) / 4)
+	return append(out,
+		cur.Add(vector2.New(n1.X-n1.Y*dx, n1.Y+n1.X*dx).Mulf(d)),
+		cur.Add(vector2.New(n2.X+n2.Y*dx, n2.Y-n2.X*dx).Mulf(d)),
+	)
+}
+
+// edgeNormal returns the unit normal to the right of the edge from a to b,
+// which points outward on a loop with positive area.
+func edgeNormal(a, b vector2.Vector2) vector2.Vector2 {
+	e := b.Sub(a)
+	return vector2.New(e.Y, -e.X).Divf(e.Length())
+}
+
+// dedupe copies path without consecutive duplicate vertices. For closed paths
+// the last vertex is also dropped if it repeats the first.
+func dedupe(path []vector2.Vector2, closed bool) []vector2.Vector2 {
+	res := make([]vector2.Vector2, 0, len(path))
+	for _, p := range path {
+		if len(res) == 0 || !res[len(res)-1].IsEqual(p) {
+			res = append(res, p)
+		}
+	}
+	if closed && len(res) > 1 && res[0].IsEqual(res[len(res)-1]) {
+		res = res[:len(res)-1]
+	}
+	return res
+}
+
+func reverse(p []vector2.Vector2) {
+	for i, j := 0, len(p)-1; i < j; i, j = i+1, j-1 {
+		p[i], p[j] = p[j], p[i]
+	}
+}
+
+// checkRing reports rings that are non-finite, degenerate or self-intersecting.
+func checkRing(ring []vector2.Vector2) error {
+	n := len(ring)
+	if n < 3 {
+		return fmt.Errorf("has %d vertices", n)
+	}
+	for i, p := range ring {
+		if !isFinite(p) {
+			return fmt.Errorf("vertex %d is %v", i, p)
+		}
+	}
+	if polygonArea(ring) == 0 {
+		return fmt.Errorf("has zero area")
+	}
+	for i := 0; i < n; i++ {
+		a, b := ring[i], ring[(i+1)%n]
+		for j := i + 2; j < n; j++ {
+			if i == 0 && j == n-1 {
+				// Adjacent through the closing edge.
+				continue
+			}
+			if segmentsIntersect(a, b, ring[j], ring[(j+1)%n]) {
+				return fmt.Errorf("edge %d crosses edge %d", i, j)
+			}
+		}
+	}
+	return nil
+}
+
+// clearanceViolations counts the vertices of ring that lie closer than |delta|
+// to the edges of src. A correct offset keeps every vertex at least that far
+// away; vertices that come closer belong to a loop that folded over itself.
+func clearanceViolations(src []vector2.Vector2, closed bool, ring []vector2.Vector2, delta float64) int {
+	edges := len(src)
+	if !closed {
+		edges--
+	}
+	limit := math.Abs(delta) * (1 - 1e-9)
+	violations := 0
+	for _, p := range ring {
+		for i := 0; i < edges; i++ {
+			segment := [2]vector2.Vector2{src[i], src[(i+1)%len(src)]}
+			if GetDistanceToSegment(p, segment) < limit {
+				violations++
+				break
+			}
+		}
+	}
+	return violations
+}
+
+func isFinite(p vector2.Vector2) bool {
+	return !math.IsNaN(p.X) && !math.IsNaN(p.Y) && !math.IsInf(p.X, 0) && !math.IsInf(p.Y, 0)
+}
+
+// segmentsIntersect reports whether segments ab and cd cross or touch.
+func segmentsIntersect(a, b, c, d vector2.Vector2) bool {
+	d1 := b.Sub(a).Cross(c.Sub(a))
+	d2 := b.Sub(a).Cross(d.Sub(a))
+	d3 := d.Sub(c).Cross(a.Sub(c))
+	d4 := d.Sub(c).Cross(b.Sub(c))
+	if ((d1 > 0 && d2 < 0) || (d1 < 0 && d2 > 0)) && ((d3 > 0 && d4 < 0) || (d3 < 0 && d4 > 0)) {
+		return true
+	}
+	return (d1 == 0 && onSegment(a, b, c)) || (d2 == 0 && onSegment(a, b, d)) ||
+		(d3 == 0 && onSegment(c, d, a)) || (d4 == 0 && onSegment(c, d, b))
+}
+
+// onSegment reports whether p, known to be collinear with ab, lies on it.
+func onSegment(a, b, p vector2.Vector2) bool {
+	return math.Min(a.X, b.X) <= p.X && p.X <= math.Max(a.X, b.X) &&
+		math.Min(a.Y, b.Y) <= p.Y && p.Y <= math.Max(a.Y, b.Y)
+}
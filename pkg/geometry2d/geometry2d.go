@@ -62,6 +62,23 @@ const (
 	EndTypeRound
 )
 
+// PolyBooleanOperation defines the operation of the polygon boolean functions.
+type BooleanOperation int
+
+const (
+	BooleanOperationUnion BooleanOperation = iota
+	BooleanOperationDifference
+	BooleanOperationIntersection
+	BooleanOperationXor
+)
+
+// scaleFactor converts between world units and clipper's fixed-point coordinates.
+const scaleFactor = 100000000.0
+
+// arcTolerance is the maximum distance, in world units, between a round join
+// and the true arc. Godot uses the same 0.25 world units.
+const arcTolerance = 0.25
+
 func GetClosestPointsBetweenSegments(p1, q1, p2, q2 vector2.Vector2) float64 {
 	d1 := q1.Sub(p1) // Direction vector of segment S1.
 	d2 := q2.Sub(p2) // Direction vector of segment S2.
@@ -209,15 +226,143 @@ func SegmentIntersectsSegment(from_a, to_a, from_b, to_b vector2.Vector2) vector
 	return from_a.Add(B.Mulf(ABpos))
 }
 
+// OffsetPolygon grows the polygon by delta, or shrinks it if delta is
+// negative. It returns no rings if the current PolygonEngine rejects the input;
+// use OffsetPolygonChecked to get the error.
 func OffsetPolygon(polygon []vector2.Vector2, delta float64, joinType JoinType) [][]vector2.Vector2 {
-	return doOffset(polygon, delta, clipper.JoinType(joinType), clipper.EtClosedPolygon)
+	res, _ := OffsetPolygonChecked(polygon, delta, joinType)
+	return res
 }
 
+// OffsetPolygonChecked is like OffsetPolygon but returns an error wrapping
+// zerogdscript.ErrInvalidGeometry when the engine rejects the input.
+func OffsetPolygonChecked(polygon []vector2.Vector2, delta float64, joinType JoinType) ([][]vector2.Vector2, error) {
+	return doOffset(polygon, delta, joinType, EndTypePolygon, arcTolerance)
+}
+
+// OffsetPolyline inflates the polyline by delta into a closed outline.
+// EndTypePolygon is not valid for polylines and returns no rings. It returns
+// no rings if the current PolygonEngine rejects the input; use
+// OffsetPolylineChecked to get the error.
 func OffsetPolyline(polygon []vector2.Vector2, delta float64, joinType JoinType, endType EndType) [][]vector2.Vector2 {
+	res, _ := OffsetPolylineChecked(polygon, delta, joinType, endType)
+	return res
+}
+
+// OffsetPolylineChecked is like OffsetPolyline but returns an error wrapping
+// zerogdscript.ErrInvalidGeometry when the engine rejects the input.
+func OffsetPolylineChecked(polygon []vector2.Vector2, delta float64, joinType JoinType, endType EndType) ([][]vector2.Vector2, error) {
 	if endType == EndTypePolygon {
+		return [][]vector2.Vector2{}, nil
+	}
+	return doOffset(polygon, delta, joinType, endType, arcTolerance)
+}
+
+// ThickenLine turns the polyline into a filled ribbon width wide, centred on
+// the line, with the given end caps. EndTypeButt stops flush with the end
+// points, EndTypeSquare extends past them by half the width and EndTypeRound
+// adds semicircles; EndTypeJoined closes the line into a loop. Corners are
+// mitered, or rounded with EndTypeRound. EndTypePolygon or a width <= 0
+// returns no rings, as does input the current PolygonEngine rejects.
+func ThickenLine(points []vector2.Vector2, width float64, cap EndType) [][]vector2.Vector2 {
+	if width <= 0 || cap == EndTypePolygon {
 		return [][]vector2.Vector2{}
 	}
-	return doOffset(polygon, delta, clipper.JoinType(joinType), clipper.EndType(endType))
+	joinType := JoinTypeMiter
+	if cap == EndTypeRound {
+		joinType = JoinTypeRound
+	}
+	res, _ := doOffset(points, width/2, joinType, cap, arcTolerance)
+	return res
+}
+
+// RoundCorners rounds every corner of the polygon with the given radius.
+// The polygon is shrunk by radius and grown back by radius with round joins,
+// so straight edges keep their position while corners become arcs.
+// If the shrink splits the polygon, only the largest piece is kept.
+// Arcs stay within 1% of the radius of the true circle.
+// A radius <= 0 returns the input unchanged.
+func RoundCorners(polygon []vector2.Vector2, radius float64) []vector2.Vector2 {
+	if radius <= 0 {
+		return polygon
+	}
+
+	inset, _ := doOffset(polygon, -radius, JoinTypeMiter, EndTypePolygon, arcTolerance)
+	if len(inset) == 0 {
+		return []vector2.Vector2{}
+	}
+
+	largest := inset[0]
+	for _, ring := range inset[1:] {
+		if math.Abs(polygonArea(ring)) > math.Abs(polygonArea(largest)) {
+			largest = ring
+		}
+	}
+
+	outset, _ := doOffset(largest, radius, JoinTypeRound, EndTypePolygon, radius*0.01)
+	if len(outset) == 0 {
+		return []vector2.Vector2{}
+	}
+	return outset[0]
+}
+
+// MergePolygons returns the union of polygon a and polygon b. Holes in the
+// result are clockwise, see IsPolygonClockwise. It returns no rings if the
+// current PolygonEngine rejects the input; use MergePolygonsChecked to get the
+// error.
+func MergePolygons(a, b []vector2.Vector2) [][]vector2.Vector2 {
+	res, _ := MergePolygonsChecked(a, b)
+	return res
+}
+
+// MergePolygonsChecked is like MergePolygons but returns an error wrapping
+// zerogdscript.ErrInvalidGeometry when the engine rejects the input.
+func MergePolygonsChecked(a, b []vector2.Vector2) ([][]vector2.Vector2, error) {
+	return engine.Boolean(BooleanOperationUnion, a, b)
+}
+
+// ClipPolygons returns what is left of polygon a after removing polygon b.
+// Holes in the result are clockwise. It returns no rings if the current
+// PolygonEngine rejects the input; use ClipPolygonsChecked to get the error.
+func ClipPolygons(a, b []vector2.Vector2) [][]vector2.Vector2 {
+	res, _ := ClipPolygonsChecked(a, b)
+	return res
+}
+
+// ClipPolygonsChecked is like ClipPolygons but returns an error wrapping
+// zerogdscript.ErrInvalidGeometry when the engine rejects the input.
+func ClipPolygonsChecked(a, b []vector2.Vector2) ([][]vector2.Vector2, error) {
+	return engine.Boolean(BooleanOperationDifference, a, b)
+}
+
+// IntersectPolygons returns the area covered by both polygon a and polygon b.
+// Holes in the result are clockwise. It returns no rings if the current
+// PolygonEngine rejects the input; use IntersectPolygonsChecked to get the
+// error.
+func IntersectPolygons(a, b []vector2.Vector2) [][]vector2.Vector2 {
+	res, _ := IntersectPolygonsChecked(a, b)
+	return res
+}
+
+// IntersectPolygonsChecked is like IntersectPolygons but returns an error
+// wrapping zerogdscript.ErrInvalidGeometry when the engine rejects the input.
+func IntersectPolygonsChecked(a, b []vector2.Vector2) ([][]vector2.Vector2, error) {
+	return engine.Boolean(BooleanOperationIntersection, a, b)
+}
+
+// ExcludePolygons returns the area covered by exactly one of polygon a and
+// polygon b. Holes in the result are clockwise. It returns no rings if the
+// current PolygonEngine rejects the input; use ExcludePolygonsChecked to get
+// the error.
+func ExcludePolygons(a, b []vector2.Vector2) [][]vector2.Vector2 {
+	res, _ := ExcludePolygonsChecked(a, b)
+	return res
+}
+
+// ExcludePolygonsChecked is like ExcludePolygons but returns an error wrapping
+// zerogdscript.ErrInvalidGeometry when the engine rejects the input.
+func ExcludePolygonsChecked(a, b []vector2.Vector2) ([][]vector2.Vector2, error) {
+	return engine.Boolean(BooleanOperationXor, a, b)
 }
 
 // IsPolygonClockwise determines if the given polygon points are in a clockwise order.
@@ -237,39 +382,47 @@ func IsPolygonClockwise(polygon []vector2.Vector2) bool {
 	return sum > 0
 }
 
+// polygonArea returns the signed area of the polygon using the shoelace formula.
+func polygonArea(polygon []vector2.Vector2) float64 {
+	c := len(polygon)
+	sum := 0.0
+	for i := 0; i < c; i++ {
+		sum += polygon[i].Cross(polygon[(i+1)%c])
+	}
+	return sum * 0.5
+}
+
 func toFixedPointPrecision(x, y float64) *clipper.IntPoint {
-	return clipper.NewIntPointFromFloat(x*100000000, y*100000000)
+	return clipper.NewIntPointFromFloat(x*scaleFactor, y*scaleFactor)
 }
 
 func toFloatingPointPrecision(value *clipper.IntPoint) vector2.Vector2 {
-	return vector2.New(float64(value.X), float64(value.Y)).Divf(100000000)
+	return vector2.New(float64(value.X), float64(value.Y)).Divf(scaleFactor)
 }
 
-func doOffset(polygon []vector2.Vector2, delta float64, jt clipper.JoinType, et clipper.EndType) [][]vector2.Vector2 {
-	clip := clipper.NewClipperOffset()
-	path := clipper.NewPath()
-	for _, pt := range polygon {
-		iPt := toFixedPointPrecision(pt.X, pt.Y)
-		path = append(path, iPt)
-	}
-	clip.AddPath(path, jt, et)
-
-	clip.ArcTolerance = 0.0
-	clip.MiterLimit = 4.0
+// doOffset offsets the path by delta through the current PolygonEngine.
+// tolerance is the arc tolerance of round joins and ends, in world units.
+func doOffset(polygon []vector2.Vector2, delta float64, jt JoinType, et EndType, tolerance float64) ([][]vector2.Vector2, error) {
+	return engine.Offset(polygon, delta, jt, et, tolerance)
+}
 
-	solutions := clip.Execute(delta * 100000000)
-	if len(solutions) == 0 {
+// copyRings copies rings into freshly allocated storage: one backing array for
+// all points and one slice of ring headers.
+func copyRings(rings [][]vector2.Vector2) [][]vector2.Vector2 {
+	if len(rings) == 0 {
 		return [][]vector2.Vector2{}
 	}
 
-	res := make([][]vector2.Vector2, len(solutions))
-	for _, solution := range solutions {
-		points := make([]vector2.Vector2, len(solution))
-		for _, pt := range solution {
-			points = append(points, toFloatingPointPrecision(pt))
-		}
-		res = append(res, points)
+	n := 0
+	for _, ring := range rings {
+		n += len(ring)
+	}
+	flat := make([]vector2.Vector2, n)
+	res := make([][]vector2.Vector2, len(rings))
+	for i, ring := range rings {
+		m := copy(flat, ring)
+		res[i] = flat[:m:m]
+		flat = flat[m:]
 	}
-
 	return res
 }
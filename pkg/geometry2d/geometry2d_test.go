@@ -1,6 +1,11 @@
 package geometry2d
 
-import "testing"
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
 
 func TestGeometry2D_GetClosestPointsBetweenSegments(t *testing.T) {}
 
@@ -16,9 +21,186 @@ func TestGeometry2D_LineIntersectsLine(t *testing.T) {}
 
 func TestGeometry2D_SegmentIntersectsSegment(t *testing.T) {}
 
-func TestGeometry2D_OffsetPolygon(t *testing.T) {}
+func TestGeometry2D_OffsetPolygon(t *testing.T) {
+	forEachEngine(t, func(t *testing.T) {
+		square := []vector2.Vector2{
+			vector2.New(0, 0),
+			vector2.New(10, 0),
+			vector2.New(10, 10),
+			vector2.New(0, 10),
+		}
+
+		// With Godot's 0.25 unit arc tolerance a radius-2 round join needs only a
+		// handful of segments per corner.
+		res := OffsetPolygon(square, 2, JoinTypeRound)
+		if len(res) != 1 {
+			t.Fatalf("OffsetPolygon() returned %d rings, want 1", len(res))
+		}
+		if n := len(res[0]); n <= len(square) || n > 64 {
+			t.Errorf("OffsetPolygon() returned %d vertices, want between %d and 64", n, len(square)+1)
+		}
+	})
+}
+
+func TestGeometry2D_OffsetPolygonUnitSquare(t *testing.T) {
+	forEachEngine(t, func(t *testing.T) {
+		square := []vector2.Vector2{
+			vector2.New(0, 0),
+			vector2.New(1, 0),
+			vector2.New(1, 1),
+			vector2.New(0, 1),
+		}
+		res := OffsetPolygon(square, 0.25, JoinTypeMiter)
+		if len(res) != 1 {
+			t.Fatalf("OffsetPolygon() returned %d rings, want 1", len(res))
+		}
+		// Exactly the four grown corners, with no zero-valued padding in front.
+		if len(res[0]) != 4 {
+			t.Fatalf("OffsetPolygon() returned %d vertices %v, want 4", len(res[0]), res[0])
+		}
+		for _, want := range []vector2.Vector2{
+			vector2.New(-0.25, -0.25), vector2.New(1.25, -0.25),
+			vector2.New(1.25, 1.25), vector2.New(-0.25, 1.25),
+		} {
+			found := false
+			for _, p := range res[0] {
+				if p.IsEqualApproxTol(want, 1e-6) {
+					found = true
+				}
+			}
+			if !found {
+				t.Errorf("OffsetPolygon() = %v, missing corner %v", res[0], want)
+			}
+		}
+	})
+}
+
+func TestGeometry2D_OffsetPolyline(t *testing.T) {
+	forEachEngine(t, func(t *testing.T) {
+		line := []vector2.Vector2{vector2.New(0, 0), vector2.New(10, 0)}
+
+		res := OffsetPolyline(line, 1, JoinTypeSquare, EndTypeButt)
+		if len(res) != 1 {
+			t.Fatalf("OffsetPolyline() returned %d rings, want 1", len(res))
+		}
+		if area := math.Abs(polygonArea(res[0])); math.Abs(area-20) > 1e-6 {
+			t.Errorf("OffsetPolyline() area = %v, want 20", area)
+		}
+
+		if res := OffsetPolyline(line, 1, JoinTypeSquare, EndTypePolygon); len(res) != 0 {
+			t.Errorf("OffsetPolyline() with EndTypePolygon returned %d rings, want 0", len(res))
+		}
+	})
+}
+
+func TestGeometry2D_ThickenLine(t *testing.T) {
+	forEachEngine(t, func(t *testing.T) {
+		line := []vector2.Vector2{vector2.New(2, 3), vector2.New(12, 3)}
+
+		for _, tt := range []struct {
+			cap        EndType
+			minX, maxX float64
+		}{
+			{EndTypeButt, 2, 12},
+			{EndTypeSquare, 1, 13},
+		} {
+			res := ThickenLine(line, 2, tt.cap)
+			if len(res) != 1 {
+				t.Fatalf("ThickenLine(cap %d) returned %d rings, want 1", tt.cap, len(res))
+			}
+			want := 2 * (tt.maxX - tt.minX)
+			if area := math.Abs(polygonArea(res[0])); math.Abs(area-want) > 1e-6 {
+				t.Errorf("ThickenLine(cap %d) area = %v, want %v", tt.cap, area, want)
+			}
+			for _, p := range res[0] {
+				if p.X < tt.minX-1e-6 || p.X > tt.maxX+1e-6 || p.Y < 2-1e-6 || p.Y > 4+1e-6 {
+					t.Errorf("ThickenLine(cap %d) vertex %v outside [%v, %v] x [2, 4]", tt.cap, p, tt.minX, tt.maxX)
+				}
+			}
+		}
+
+		// Round caps add a half disc at each end.
+		res := ThickenLine(line, 2, EndTypeRound)
+		if len(res) != 1 {
+			t.Fatalf("ThickenLine(round) returned %d rings, want 1", len(res))
+		}
+		if area, want := math.Abs(polygonArea(res[0])), 20+math.Pi; math.Abs(area-want) > 0.05*want {
+			t.Errorf("ThickenLine(round) area = %v, want about %v", area, want)
+		}
+
+		if res := ThickenLine(line, 0, EndTypeButt); len(res) != 0 {
+			t.Errorf("ThickenLine() with zero width returned %d rings, want 0", len(res))
+		}
+		if res := ThickenLine(line, 2, EndTypePolygon); len(res) != 0 {
+			t.Errorf("ThickenLine() with EndTypePolygon returned %d rings, want 0", len(res))
+		}
+	})
+}
+
+func TestGeometry2D_RoundCorners(t *testing.T) {
+	forEachEngine(t, func(t *testing.T) {
+		square := []vector2.Vector2{
+			vector2.New(0, 0),
+			vector2.New(10, 0),
+			vector2.New(10, 10),
+			vector2.New(0, 10),
+		}
+		radius := 2.0
+		centers := []vector2.Vector2{
+			vector2.New(2, 2),
+			vector2.New(8, 2),
+			vector2.New(8, 8),
+			vector2.New(2, 8),
+		}
+
+		rounded := RoundCorners(square, radius)
+		if len(rounded) <= len(square) {
+			t.Fatalf("expected corners to be replaced by arcs, got %d vertices", len(rounded))
+		}
+		if len(rounded) > 200 {
+			t.Fatalf("expected a bounded number of arc vertices, got %d", len(rounded))
+		}
+
+		onArc, onEdge := 0, 0
+		for _, p := range rounded {
+			if p.X < -1e-6 || p.X > 10+1e-6 || p.Y < -1e-6 || p.Y > 10+1e-6 {
+				t.Fatalf("vertex %v lies outside the original square", p)
+			}
+			inCornerRegion := (p.X < 2 || p.X > 8) && (p.Y < 2 || p.Y > 8)
+			if inCornerRegion {
+				nearest := centers[0]
+				for _, c := range centers[1:] {
+					if p.DistanceTo(c) < p.DistanceTo(nearest) {
+						nearest = c
+					}
+				}
+				if math.Abs(p.DistanceTo(nearest)-radius) > radius*0.01 {
+					t.Errorf("corner vertex %v is %v from its arc center, want %v", p, p.DistanceTo(nearest), radius)
+				}
+				onArc++
+				continue
+			}
+			edge := math.Min(math.Min(math.Abs(p.X), math.Abs(p.X-10)), math.Min(math.Abs(p.Y), math.Abs(p.Y-10)))
+			if edge > 1e-6 {
+				t.Errorf("edge vertex %v does not lie on the original square's edges", p)
+			}
+			onEdge++
+		}
+		if onArc == 0 || onEdge == 0 {
+			t.Errorf("expected both arc and edge vertices, got %d arc and %d edge", onArc, onEdge)
+		}
 
-func TestGeometry2D_OffsetPolyline(t *testing.T) {}
+		unchanged := RoundCorners(square, 0)
+		if len(unchanged) != len(square) {
+			t.Fatalf("zero radius should return the input, got %v", unchanged)
+		}
+		for i := range square {
+			if !unchanged[i].IsEqual(square[i]) {
+				t.Errorf("zero radius changed vertex %d: got %v, want %v", i, unchanged[i], square[i])
+			}
+		}
+	})
+}
 
 func TestGeometry2D_IsPolygonClockwise(t *testing.T) {}
 
@@ -0,0 +1,125 @@
+package geometry2d
+
+import (
+	"sync"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	clipper "github.com/ctessum/go.clipper"
+)
+
+// offsetterPool backs the OffsetPolygon and OffsetPolyline convenience functions.
+var offsetterPool = sync.Pool{
+	New: func() any { return NewOffsetter() },
+}
+
+// Offsetter offsets polygons and polylines like OffsetPolygon and
+// OffsetPolyline, through the same PolygonEngine. With ClipperEngine it reuses
+// its clipper state, fixed-point points and output slices across calls, and
+// the rings it returns are only valid until the next call; copy them to keep
+// them. Other engines allocate fresh rings on every call.
+//
+// An Offsetter is not safe for concurrent use. Use one per goroutine.
+// The zero value is ready to use.
+type Offsetter struct {
+	// Engine is the PolygonEngine to offset with. If nil, the one selected
+	// with SetPolygonEngine is used.
+	Engine PolygonEngine
+
+	clip   *clipper.ClipperOffset
+	points []clipper.IntPoint
+	path   clipper.Path
+	buf    []vector2.Vector2
+	rings  [][]vector2.Vector2
+}
+
+// NewOffsetter returns a ready-to-use Offsetter.
+func NewOffsetter() *Offsetter {
+	return &Offsetter{clip: clipper.NewClipperOffset()}
+}
+
+// OffsetPolygon is like the OffsetPolygon function but reuses the Offsetter's buffers.
+func (o *Offsetter) OffsetPolygon(polygon []vector2.Vector2, delta float64, joinType JoinType) [][]vector2.Vector2 {
+	return o.do(polygon, delta, joinType, EndTypePolygon)
+}
+
+// OffsetPolyline is like the OffsetPolyline function but reuses the Offsetter's buffers.
+func (o *Offsetter) OffsetPolyline(polygon []vector2.Vector2, delta float64, joinType JoinType, endType EndType) [][]vector2.Vector2 {
+	if endType == EndTypePolygon {
+		return o.reset()
+	}
+	return o.do(polygon, delta, joinType, endType)
+}
+
+// do offsets through the Offsetter's engine, returning no rings on error.
+func (o *Offsetter) do(polygon []vector2.Vector2, delta float64, joinType JoinType, endType EndType) [][]vector2.Vector2 {
+	e := o.Engine
+	if e == nil {
+		e = engine
+	}
+	var res [][]vector2.Vector2
+	var err error
+	if c, ok := e.(ClipperEngine); ok {
+		res, err = c.offset(o, polygon, delta, joinType, endType, arcTolerance)
+	} else {
+		res, err = e.Offset(polygon, delta, joinType, endType, arcTolerance)
+	}
+	if err != nil {
+		return o.reset()
+	}
+	return res
+}
+
+// reset empties the reused result and returns it.
+func (o *Offsetter) reset() [][]vector2.Vector2 {
+	if o.rings == nil {
+		o.rings = make([][]vector2.Vector2, 0, 1)
+	}
+	o.rings = o.rings[:0]
+	return o.rings
+}
+
+func (o *Offsetter) offset(polygon []vector2.Vector2, delta float64, jt clipper.JoinType, et clipper.EndType, tolerance float64) [][]vector2.Vector2 {
+	if o.clip == nil {
+		o.clip = clipper.NewClipperOffset()
+	}
+
+	if cap(o.points) < len(polygon) {
+		o.points = make([]clipper.IntPoint, len(polygon))
+		o.path = make(clipper.Path, len(polygon))
+	}
+	o.points = o.points[:len(polygon)]
+	o.path = o.path[:len(polygon)]
+	for i, pt := range polygon {
+		o.points[i] = clipper.IntPoint{X: clipper.CInt(pt.X * scaleFactor), Y: clipper.CInt(pt.Y * scaleFactor)}
+		o.path[i] = &o.points[i]
+	}
+
+	o.clip.Clear()
+	o.clip.AddPath(o.path, jt, et)
+	o.clip.ArcTolerance = tolerance * scaleFactor
+	o.clip.MiterLimit = 4.0
+
+	solutions := o.clip.Execute(delta * scaleFactor)
+	// Drop clipper's references to the reused points.
+	o.clip.Clear()
+
+	res := o.reset()
+	n := 0
+	for _, solution := range solutions {
+		n += len(solution)
+	}
+	if cap(o.buf) < n {
+		o.buf = make([]vector2.Vector2, n)
+	}
+	buf := o.buf[:n]
+	for _, solution := range solutions {
+		ring := buf[:len(solution):len(solution)]
+		for i, pt := range solution {
+			ring[i] = toFloatingPointPrecision(pt)
+		}
+		res = append(res, ring)
+		buf = buf[len(solution):]
+	}
+	o.rings = res
+	return res
+}
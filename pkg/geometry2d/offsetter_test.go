@@ -0,0 +1,180 @@
+package geometry2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	clipper "github.com/ctessum/go.clipper"
+)
+
+// referenceOffset is the allocation-heavy conversion the Offsetter replaced.
+// It is kept to check that results stay identical.
+func referenceOffset(polygon []vector2.Vector2, delta float64, jt clipper.JoinType, et clipper.EndType, tolerance float64) [][]vector2.Vector2 {
+	clip := clipper.NewClipperOffset()
+	path := clipper.NewPath()
+	for _, pt := range polygon {
+		path = append(path, toFixedPointPrecision(pt.X, pt.Y))
+	}
+	clip.AddPath(path, jt, et)
+	clip.ArcTolerance = tolerance * scaleFactor
+	clip.MiterLimit = 4.0
+
+	solutions := clip.Execute(delta * scaleFactor)
+	res := make([][]vector2.Vector2, 0, len(solutions))
+	for _, solution := range solutions {
+		points := make([]vector2.Vector2, 0, len(solution))
+		for _, pt := range solution {
+			points = append(points, toFloatingPointPrecision(pt))
+		}
+		res = append(res, points)
+	}
+	return res
+}
+
+// starPolygon returns a star with n points alternating between two radii.
+func starPolygon(n int, inner, outer float64) []vector2.Vector2 {
+	res := make([]vector2.Vector2, n)
+	for i := range res {
+		r := outer
+		if i%2 == 1 {
+			r = inner
+		}
+		a := 2 * math.Pi * float64(i) / float64(n)
+		res[i] = vector2.New(r*math.Cos(a), r*math.Sin(a))
+	}
+	return res
+}
+
+func ringsEqual(a, b [][]vector2.Vector2) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if len(a[i]) != len(b[i]) {
+			return false
+		}
+		for j := range a[i] {
+			if !a[i][j].IsEqual(b[i][j]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestOffsetter_MatchesReference(t *testing.T) {
+	square := []vector2.Vector2{vector2.New(0, 0), vector2.New(10, 0), vector2.New(10, 10), vector2.New(0, 10)}
+	line := []vector2.Vector2{vector2.New(0, 0), vector2.New(5, 1), vector2.New(9, -3)}
+	star := starPolygon(24, 3, 6)
+
+	o := NewOffsetter()
+	for _, delta := range []float64{-1, 0.5, 2} {
+		for _, jt := range []JoinType{JoinTypeSquare, JoinTypeRound, JoinTypeMiter} {
+			for _, poly := range [][]vector2.Vector2{square, star} {
+				want := referenceOffset(poly, delta, clipper.JoinType(jt), clipper.EtClosedPolygon, arcTolerance)
+				if got := o.OffsetPolygon(poly, delta, jt); !ringsEqual(got, want) {
+					t.Errorf("Offsetter.OffsetPolygon(delta %v, join %v) differs from the reference", delta, jt)
+				}
+				if got := OffsetPolygon(poly, delta, jt); !ringsEqual(got, want) {
+					t.Errorf("OffsetPolygon(delta %v, join %v) differs from the reference", delta, jt)
+				}
+			}
+			if delta <= 0 {
+				continue
+			}
+			for _, et := range []EndType{EndTypeButt, EndTypeSquare, EndTypeRound} {
+				want := referenceOffset(line, delta, clipper.JoinType(jt), clipper.EndType(et), arcTolerance)
+				if got := o.OffsetPolyline(line, delta, jt, et); !ringsEqual(got, want) {
+					t.Errorf("Offsetter.OffsetPolyline(delta %v, join %v, end %v) differs from the reference", delta, jt, et)
+				}
+			}
+		}
+	}
+	if got := o.OffsetPolyline(line, 1, JoinTypeRound, EndTypePolygon); got == nil || len(got) != 0 {
+		t.Errorf("OffsetPolyline with EndTypePolygon = %v, want an empty result", got)
+	}
+}
+
+func TestOffsetter_Reuse(t *testing.T) {
+	small := starPolygon(8, 1, 2)
+	large := starPolygon(200, 4, 5)
+	wantSmall := OffsetPolygon(small, 0.5, JoinTypeMiter)
+	wantLarge := OffsetPolygon(large, 0.5, JoinTypeRound)
+
+	var o Offsetter // the zero value must work too
+	for i := 0; i < 3; i++ {
+		if got := o.OffsetPolygon(large, 0.5, JoinTypeRound); !ringsEqual(got, wantLarge) {
+			t.Fatalf("pass %d: large polygon differs after reuse", i)
+		}
+		if got := o.OffsetPolygon(small, 0.5, JoinTypeMiter); !ringsEqual(got, wantSmall) {
+			t.Fatalf("pass %d: small polygon differs after reuse", i)
+		}
+		if got := o.OffsetPolygon(nil, 0.5, JoinTypeMiter); len(got) != 0 {
+			t.Fatalf("pass %d: empty polygon returned %v", i, got)
+		}
+	}
+
+	// Results from the convenience functions are owned by the caller.
+	first := OffsetPolygon(small, 0.5, JoinTypeMiter)
+	OffsetPolygon(large, 0.5, JoinTypeRound)
+	if !ringsEqual(first, wantSmall) {
+		t.Errorf("OffsetPolygon result changed after a later call")
+	}
+}
+
+func TestOffsetter_Engine(t *testing.T) {
+	star := starPolygon(24, 3, 6)
+	want, err := FloatEngine{}.Offset(star, 0.5, JoinTypeRound, EndTypePolygon, arcTolerance)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	o := Offsetter{Engine: FloatEngine{}}
+	if got := o.OffsetPolygon(star, 0.5, JoinTypeRound); !ringsEqual(got, want) {
+		t.Errorf("Offsetter with FloatEngine differs from FloatEngine.Offset")
+	}
+
+	// A nil Engine follows SetPolygonEngine.
+	SetPolygonEngine(FloatEngine{})
+	defer SetPolygonEngine(nil)
+	var z Offsetter
+	if got := z.OffsetPolygon(star, 0.5, JoinTypeRound); !ringsEqual(got, want) {
+		t.Errorf("Offsetter with a nil Engine ignores SetPolygonEngine")
+	}
+
+	bad := []vector2.Vector2{vector2.New(0, 0), vector2.New(10, 0), vector2.New(math.NaN(), 5)}
+	for _, e := range []PolygonEngine{ClipperEngine{}, FloatEngine{}} {
+		o := Offsetter{Engine: e}
+		if got := o.OffsetPolygon(bad, 1, JoinTypeSquare); got == nil || len(got) != 0 {
+			t.Errorf("Offsetter with %T and a NaN vertex = %v, want an empty result", e, got)
+		}
+	}
+}
+
+var benchRings [][]vector2.Vector2
+
+func BenchmarkOffset_Reference(b *testing.B) {
+	poly := starPolygon(1000, 90, 100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchRings = referenceOffset(poly, 2, clipper.JtMiter, clipper.EtClosedPolygon, arcTolerance)
+	}
+}
+
+func BenchmarkOffset_OffsetPolygon(b *testing.B) {
+	poly := starPolygon(1000, 90, 100)
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchRings = OffsetPolygon(poly, 2, JoinTypeMiter)
+	}
+}
+
+func BenchmarkOffset_Offsetter(b *testing.B) {
+	poly := starPolygon(1000, 90, 100)
+	o := NewOffsetter()
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		benchRings = o.OffsetPolygon(poly, 2, JoinTypeMiter)
+	}
+}
@@ -0,0 +1,32 @@
+package geometry2d
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// PolylineLength returns the total length of the segments of a polyline.
+func PolylineLength(points []vector2.Vector2) float64 {
+	length := 0.0
+	for i := 1; i < len(points); i++ {
+		length += points[i-1].DistanceTo(points[i])
+	}
+	return length
+}
+
+// PolylineCurvature returns the signed turn angle in radians at each interior
+// vertex of a polyline, so element i belongs to points[i+1]. The angle is in
+// [-PI, PI] and positive when the path turns from +X toward +Y, which is
+// clockwise on screen in Godot's Y-down 2D space. A zero-length segment gives
+// a turn of 0 at its ends. Fewer than three points give an empty slice.
+func PolylineCurvature(points []vector2.Vector2) []float64 {
+	if len(points) < 3 {
+		return []float64{}
+	}
+	turns := make([]float64, len(points)-2)
+	for i := range turns {
+		in := points[i+1].Sub(points[i])
+		out := points[i+2].Sub(points[i+1])
+		turns[i] = in.AngleTo(out)
+	}
+	return turns
+}
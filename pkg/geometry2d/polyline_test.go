@@ -0,0 +1,66 @@
+package geometry2d
+
+import (
+	"math"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+func TestGeometry2D_PolylineLength(t *testing.T) {
+	tests := []struct {
+		name   string
+		points []vector2.Vector2
+		want   float64
+	}{
+		{"empty", nil, 0},
+		{"single point", []vector2.Vector2{vector2.New(1, 1)}, 0},
+		{"straight", []vector2.Vector2{vector2.New(0, 0), vector2.New(3, 0), vector2.New(10, 0)}, 10},
+		{"right angle", []vector2.Vector2{vector2.New(0, 0), vector2.New(3, 0), vector2.New(3, 4)}, 7},
+		{"diagonal", []vector2.Vector2{vector2.New(0, 0), vector2.New(3, 4)}, 5},
+	}
+	for _, tt := range tests {
+		if got := PolylineLength(tt.points); !zerogdscript.IsEqualApprox(got, tt.want) {
+			t.Errorf("%s: PolylineLength = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGeometry2D_PolylineCurvature(t *testing.T) {
+	check := func(name string, points []vector2.Vector2, want []float64) {
+		t.Helper()
+		got := PolylineCurvature(points)
+		if len(got) != len(want) {
+			t.Fatalf("%s: got %d turns, want %d", name, len(got), len(want))
+		}
+		for i := range want {
+			if !zerogdscript.IsEqualApprox(got[i], want[i]) {
+				t.Errorf("%s: turn %d = %v, want %v", name, i, got[i], want[i])
+			}
+		}
+	}
+
+	check("too short", []vector2.Vector2{vector2.New(0, 0), vector2.New(1, 0)}, []float64{})
+	check("straight", []vector2.Vector2{vector2.New(0, 0), vector2.New(1, 1), vector2.New(2, 2), vector2.New(5, 5)}, []float64{0, 0})
+	check("left turn", []vector2.Vector2{vector2.New(0, 0), vector2.New(2, 0), vector2.New(2, 2)}, []float64{math.Pi / 2})
+	check("right turn", []vector2.Vector2{vector2.New(0, 0), vector2.New(2, 0), vector2.New(2, -2)}, []float64{-math.Pi / 2})
+	check("repeated point", []vector2.Vector2{vector2.New(0, 0), vector2.New(1, 0), vector2.New(1, 0), vector2.New(2, 0)}, []float64{0, 0})
+
+	// Points on a counter-clockwise arc turn by the step angle at every vertex
+	// and the arc length approaches r*angle.
+	const n, r, step = 32, 5.0, math.Pi / 32
+	arc := make([]vector2.Vector2, n+1)
+	for i := range arc {
+		a := float64(i) * step
+		arc[i] = vector2.New(r*math.Cos(a), r*math.Sin(a))
+	}
+	want := make([]float64, n-1)
+	for i := range want {
+		want[i] = step
+	}
+	check("arc", arc, want)
+	if got, exact := PolylineLength(arc), r*math.Pi; math.Abs(got-exact) > 0.01 {
+		t.Errorf("arc length = %v, want about %v", got, exact)
+	}
+}
@@ -0,0 +1,89 @@
+package geometry2d
+
+import (
+	"math"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// ConvexPolygonsIntersect reports whether two convex polygons overlap, using
+// the Separating Axis Theorem over the edge normals of both. Polygons that
+// only touch along an edge or at a vertex count as intersecting. Either
+// winding works; polygons with fewer than three vertices never intersect.
+// The result is undefined for concave polygons.
+func ConvexPolygonsIntersect(a, b []vector2.Vector2) bool {
+	if len(a) < 3 || len(b) < 3 {
+		return false
+	}
+	for _, poly := range [2][]vector2.Vector2{a, b} {
+		for i := range poly {
+			axis := edgeAxis(poly, i)
+			if axis == vector2.Zero() {
+				continue
+			}
+			minA, maxA := projectPolygon(a, axis)
+			minB, maxB := projectPolygon(b, axis)
+			if maxA < minB || maxB < minA {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+// ConvexPolygonsMTV returns the minimum translation vector for two convex
+// polygons: the shortest translation that, applied to a, moves it out of b
+// so the two only touch. The second result is false, with a zero vector, if
+// the polygons do not intersect. Polygons that already only touch return a
+// zero vector and true, as ConvexPolygonsIntersect reports them as
+// intersecting. The same input rules as ConvexPolygonsIntersect apply.
+func ConvexPolygonsMTV(a, b []vector2.Vector2) (vector2.Vector2, bool) {
+	if len(a) < 3 || len(b) < 3 {
+		return vector2.Zero(), false
+	}
+	var mtv vector2.Vector2
+	best := math.Inf(1)
+	for _, poly := range [2][]vector2.Vector2{a, b} {
+		for i := range poly {
+			axis := edgeAxis(poly, i)
+			if axis == vector2.Zero() {
+				continue
+			}
+			axis = axis.Normalized()
+			minA, maxA := projectPolygon(a, axis)
+			minB, maxB := projectPolygon(b, axis)
+			if maxA < minB || maxB < minA {
+				return vector2.Zero(), false
+			}
+			// Push a back along the axis or forward, whichever is shorter.
+			if back := maxA - minB; back < best {
+				best, mtv = back, axis.Mulf(-back)
+			}
+			if forward := maxB - minA; forward < best {
+				best, mtv = forward, axis.Mulf(forward)
+			}
+		}
+	}
+	return mtv, true
+}
+
+// edgeAxis returns the (unnormalized) normal of the edge starting at poly[i].
+func edgeAxis(poly []vector2.Vector2, i int) vector2.Vector2 {
+	edge := poly[(i+1)%len(poly)].Sub(poly[i])
+	return vector2.New(-edge.Y, edge.X)
+}
+
+// projectPolygon returns the interval covered by poly projected onto axis.
+func projectPolygon(poly []vector2.Vector2, axis vector2.Vector2) (min, max float64) {
+	min = poly[0].Dot(axis)
+	max = min
+	for _, p := range poly[1:] {
+		d := p.Dot(axis)
+		if d < min {
+			min = d
+		} else if d > max {
+			max = d
+		}
+	}
+	return min, max
+}
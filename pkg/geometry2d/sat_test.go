@@ -0,0 +1,111 @@
+package geometry2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// square returns a square of half-size h centred on c, rotated by angle.
+func square(c vector2.Vector2, h, angle float64) []vector2.Vector2 {
+	corners := []vector2.Vector2{vector2.New(-h, -h), vector2.New(h, -h), vector2.New(h, h), vector2.New(-h, h)}
+	for i, p := range corners {
+		corners[i] = p.Rotated(angle).Add(c)
+	}
+	return corners
+}
+
+func TestGeometry2D_ConvexPolygonsIntersect(t *testing.T) {
+	triangle := []vector2.Vector2{vector2.New(0, 0), vector2.New(4, 0), vector2.New(0, 4)}
+	tests := []struct {
+		name string
+		a, b []vector2.Vector2
+		want bool
+	}{
+		{"overlapping squares", square(vector2.Zero(), 1, 0), square(vector2.New(1.5, 0.5), 1, 0), true},
+		{"contained", square(vector2.Zero(), 3, 0), square(vector2.New(0.5, 0), 1, 0), true},
+		{"edge touching", square(vector2.Zero(), 1, 0), square(vector2.New(2, 0.5), 1, 0), true},
+		{"corner touching", square(vector2.Zero(), 1, 0), square(vector2.New(2, 2), 1, 0), true},
+		{"separated", square(vector2.Zero(), 1, 0), square(vector2.New(2.1, 0), 1, 0), false},
+		{"separated diagonally", square(vector2.Zero(), 1, 0), square(vector2.New(2.1, 2.1), 1, 0), false},
+		// Axis-aligned bounds overlap but the hypotenuse separates them.
+		{"triangle gap", triangle, square(vector2.New(3, 3), 0.9, 0), false},
+		{"triangle overlap", triangle, square(vector2.New(2, 2), 0.5, 0), true},
+		// The diamond's corner reaches 1.414 from its centre.
+		{"rotated square overlapping", square(vector2.Zero(), 1, 0), square(vector2.New(2.3, 0), 1, math.Pi/4), true},
+		{"rotated square separated", square(vector2.Zero(), 1, 0), square(vector2.New(2.5, 0), 1, math.Pi/4), false},
+		{"rotated square diagonal gap", square(vector2.Zero(), 1, 0), square(vector2.New(2.3, 2.3), 1, math.Pi/4), false},
+		{"degenerate", []vector2.Vector2{vector2.New(0, 0), vector2.New(1, 1)}, square(vector2.Zero(), 1, 0), false},
+	}
+	for _, tt := range tests {
+		if got := ConvexPolygonsIntersect(tt.a, tt.b); got != tt.want {
+			t.Errorf("%s: ConvexPolygonsIntersect = %v, want %v", tt.name, got, tt.want)
+		}
+		if got := ConvexPolygonsIntersect(tt.b, tt.a); got != tt.want {
+			t.Errorf("%s (swapped): ConvexPolygonsIntersect = %v, want %v", tt.name, got, tt.want)
+		}
+		// Winding does not matter.
+		ra := append([]vector2.Vector2(nil), tt.a...)
+		reverse(ra)
+		if got := ConvexPolygonsIntersect(ra, tt.b); got != tt.want {
+			t.Errorf("%s (reversed): ConvexPolygonsIntersect = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
+
+func TestGeometry2D_ConvexPolygonsMTV(t *testing.T) {
+	tests := []struct {
+		name string
+		a, b []vector2.Vector2
+		want vector2.Vector2
+	}{
+		{"overlap on x", square(vector2.New(1.5, 0.2), 1, 0), square(vector2.Zero(), 1, 0), vector2.New(0.5, 0)},
+		{"overlap on -y", square(vector2.New(0.1, -1.8), 1, 0), square(vector2.Zero(), 1, 0), vector2.New(0, -0.2)},
+		{"contained", square(vector2.New(0.5, 0), 0.5, 0), square(vector2.Zero(), 3, 0), vector2.New(3, 0)},
+		// The diamond's corner reaches 1.414 from its centre.
+		{"rotated", square(vector2.New(2.3, 0), 1, math.Pi/4), square(vector2.Zero(), 1, 0), vector2.New(1+math.Sqrt2-2.3, 0)},
+		{"edge touching", square(vector2.New(2, 0.5), 1, 0), square(vector2.Zero(), 1, 0), vector2.Zero()},
+	}
+	for _, tt := range tests {
+		got, ok := ConvexPolygonsMTV(tt.a, tt.b)
+		if !ok || !got.IsEqualApprox(tt.want) {
+			t.Errorf("%s: ConvexPolygonsMTV = %v, %v, want %v, true", tt.name, got, ok, tt.want)
+			continue
+		}
+		// Swapping the polygons flips the vector.
+		if back, ok := ConvexPolygonsMTV(tt.b, tt.a); !ok || !back.IsEqualApprox(got.Mulf(-1)) {
+			t.Errorf("%s (swapped): ConvexPolygonsMTV = %v, %v, want %v, true", tt.name, back, ok, got.Mulf(-1))
+		}
+		if got == vector2.Zero() {
+			continue
+		}
+		// Moving a by the MTV leaves the polygons just touching: still
+		// intersecting, but separated by any further nudge along it.
+		moved := make([]vector2.Vector2, len(tt.a))
+		nudged := make([]vector2.Vector2, len(tt.a))
+		for i, p := range tt.a {
+			moved[i] = p.Add(got)
+			nudged[i] = moved[i].Add(got.Normalized().Mulf(1e-6))
+		}
+		if _, ok := ConvexPolygonsMTV(moved, tt.b); !ok {
+			t.Errorf("%s: polygons no longer touch after applying the MTV", tt.name)
+		}
+		if ConvexPolygonsIntersect(nudged, tt.b) {
+			t.Errorf("%s: polygons still overlap after applying the MTV", tt.name)
+		}
+	}
+
+	for _, tt := range []struct {
+		name string
+		a, b []vector2.Vector2
+	}{
+		{"separated", square(vector2.Zero(), 1, 0), square(vector2.New(2.1, 0), 1, 0)},
+		{"rotated separated", square(vector2.Zero(), 1, 0), square(vector2.New(2.3, 2.3), 1, math.Pi/4)},
+		{"degenerate", []vector2.Vector2{vector2.New(0, 0), vector2.New(1, 1)}, square(vector2.Zero(), 1, 0)},
+	} {
+		if got, ok := ConvexPolygonsMTV(tt.a, tt.b); ok || got != vector2.Zero() {
+			t.Errorf("%s: ConvexPolygonsMTV = %v, %v, want zero, false", tt.name, got, ok)
+		}
+	}
+}
@@ -0,0 +1,253 @@
+package geometry2d
+
+import (
+	"math"
+	"sort"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// TriangulatePolygon triangulates a simple polygon by ear clipping. It returns
+// the vertex indices of the triangles, three per triangle and all with the
+// same winding as the polygon. It returns an empty slice if the polygon cannot
+// be triangulated, for example because it intersects itself.
+func TriangulatePolygon(polygon []vector2.Vector2) []int {
+	ring := make([]int, len(polygon))
+	for i := range ring {
+		ring[i] = i
+	}
+	return earClip(polygon, ring)
+}
+
+// TriangulatePolygonWithHoles triangulates the area inside outer and outside
+// every hole. The indices refer to outer followed by each hole in order, as if
+// they were appended into a single slice. Each hole is bridged into the outer
+// ring before ear clipping. Holes must lie inside outer and must not overlap
+// each other. It returns an empty slice if triangulation fails.
+func TriangulatePolygonWithHoles(outer []vector2.Vector2, holes [][]vector2.Vector2) []int {
+	n := len(outer)
+	for _, hole := range holes {
+		n += len(hole)
+	}
+	points := make([]vector2.Vector2, 0, n)
+	points = append(points, outer...)
+
+	ring := make([]int, len(outer))
+	for i := range ring {
+		ring[i] = i
+	}
+	clockwise := polygonArea(outer) < 0
+
+	// Holes are stored with the opposite winding to outer.
+	type holeRing struct {
+		indices []int
+		right   int // position in indices of the rightmost vertex
+	}
+	hs := make([]holeRing, 0, len(holes))
+	for _, hole := range holes {
+		if len(hole) < 3 {
+			points = append(points, hole...)
+			continue
+		}
+		base := len(points)
+		points = append(points, hole...)
+		idx := make([]int, len(hole))
+		for i := range idx {
+			idx[i] = base + i
+		}
+		if (polygonArea(hole) < 0) == clockwise {
+			for i, j := 0, len(idx)-1; i < j; i, j = i+1, j-1 {
+				idx[i], idx[j] = idx[j], idx[i]
+			}
+		}
+		right := 0
+		for i, k := range idx {
+			if points[k].X > points[idx[right]].X {
+				right = i
+			}
+		}
+		hs = append(hs, holeRing{idx, right})
+	}
+
+	// Bridge holes from right to left, so each bridge only has to see the
+	// outer ring and the holes already merged into it.
+	sort.Slice(hs, func(a, b int) bool {
+		return points[hs[a].indices[hs[a].right]].X > points[hs[b].indices[hs[b].right]].X
+	})
+	for _, h := range hs {
+		v := bridgeVertex(points, ring, h.indices[h.right], clockwise)
+		if v < 0 {
+			return []int{}
+		}
+		merged := make([]int, 0, len(ring)+len(h.indices)+2)
+		merged = append(merged, ring[:v+1]...)
+		for i := 0; i <= len(h.indices); i++ {
+			merged = append(merged, h.indices[(h.right+i)%len(h.indices)])
+		}
+		merged = append(merged, ring[v:]...)
+		ring = merged
+	}
+
+	return earClip(points, ring)
+}
+
+// bridgeVertex returns the position in ring of a vertex that the hole vertex
+// m can connect to without crossing any edge, or -1 if there is none. It casts
+// a ray from m towards +X and picks the nearest edge it hits, following
+// Eberly's "Triangulation by Ear Clipping".
+func bridgeVertex(points []vector2.Vector2, ring []int, m int, clockwise bool) int {
+	mp := points[m]
+	n := len(ring)
+
+	best, bestX := -1, math.Inf(1)
+	for i := 0; i < n; i++ {
+		a, b := points[ring[i]], points[ring[(i+1)%n]]
+		if (a.Y > mp.Y) == (b.Y > mp.Y) {
+			continue
+		}
+		x := a.X + (mp.Y-a.Y)*(b.X-a.X)/(b.Y-a.Y)
+		if x < mp.X || x >= bestX {
+			continue
+		}
+		bestX = x
+		// Of the edge's endpoints, take the one with the larger X.
+		if a.X > b.X {
+			best = i
+		} else {
+			best = (i + 1) % n
+		}
+	}
+	if best < 0 {
+		return -1
+	}
+
+	// Any reflex vertex inside the triangle m, hit point, candidate would
+	// block the view; take the one closest in angle to the ray instead.
+	hit := vector2.New(bestX, mp.Y)
+	cand := points[ring[best]]
+	if cand.IsEqual(hit) {
+		return best
+	}
+	bestCos := -1.0
+	if d := cand.Sub(mp); d.Length() > 0 {
+		bestCos = d.X / d.Length()
+	}
+	for i := 0; i < n; i++ {
+		p := points[ring[i]]
+		if i == best || p.X < mp.X || !pointInTriangle(p, mp, hit, cand) {
+			continue
+		}
+		if isConvex(points[ring[(i+n-1)%n]], p, points[ring[(i+1)%n]], clockwise) {
+			continue
+		}
+		d := p.Sub(mp)
+		l := d.Length()
+		if l == 0 {
+			continue
+		}
+		if c := d.X / l; c > bestCos {
+			best, bestCos = i, c
+		}
+	}
+	return best
+}
+
+// earClip triangulates the polygon formed by points[ring[0]], points[ring[1]], ...
+// Repeated positions, as left by hole bridges, are allowed.
+func earClip(points []vector2.Vector2, ring []int) []int {
+	n := len(ring)
+	if n < 3 {
+		return []int{}
+	}
+	area := 0.0
+	for i := 0; i < n; i++ {
+		area += points[ring[i]].Cross(points[ring[(i+1)%n]])
+	}
+	if area == 0 {
+		return []int{}
+	}
+	clockwise := area < 0
+
+	ring = append([]int(nil), ring...)
+	res := make([]int, 0, (n-2)*3)
+	for misses := 0; len(ring) > 3; {
+		if misses >= len(ring) {
+			// No ear left: drop a degenerate vertex or give up.
+			if !dropCollinear(points, &ring) {
+				return []int{}
+			}
+			misses = 0
+			continue
+		}
+		i := misses
+		m := len(ring)
+		a, b, c := ring[(i+m-1)%m], ring[i], ring[(i+1)%m]
+		if !isEar(points, ring, a, b, c, clockwise) {
+			misses++
+			continue
+		}
+		res = append(res, a, b, c)
+		ring = append(ring[:i], ring[i+1:]...)
+		misses = 0
+	}
+	if points[ring[0]].Sub(points[ring[1]]).Cross(points[ring[2]].Sub(points[ring[1]])) != 0 {
+		res = append(res, ring[0], ring[1], ring[2])
+	}
+	return res
+}
+
+// isEar reports whether the corner a, b, c is convex and no reflex vertex of
+// the ring lies inside it.
+func isEar(points []vector2.Vector2, ring []int, a, b, c int, clockwise bool) bool {
+	pa, pb, pc := points[a], points[b], points[c]
+	if !isConvex(pa, pb, pc, clockwise) {
+		return false
+	}
+	m := len(ring)
+	for j, k := range ring {
+		p := points[k]
+		if p.IsEqual(pa) || p.IsEqual(pb) || p.IsEqual(pc) {
+			continue
+		}
+		if isConvex(points[ring[(j+m-1)%m]], p, points[ring[(j+1)%m]], clockwise) {
+			continue
+		}
+		if pointInTriangle(p, pa, pb, pc) {
+			return false
+		}
+	}
+	return true
+}
+
+// dropCollinear removes one vertex that makes no turn. It reports whether it found one.
+func dropCollinear(points []vector2.Vector2, ring *[]int) bool {
+	r := *ring
+	m := len(r)
+	for i := range r {
+		a, b, c := points[r[(i+m-1)%m]], points[r[i]], points[r[(i+1)%m]]
+		if b.Sub(a).Cross(c.Sub(b)) == 0 {
+			*ring = append(r[:i], r[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// isConvex reports whether b turns the same way as the polygon's winding.
+func isConvex(a, b, c vector2.Vector2, clockwise bool) bool {
+	cross := b.Sub(a).Cross(c.Sub(b))
+	if clockwise {
+		return cross < 0
+	}
+	return cross > 0
+}
+
+// pointInTriangle reports whether p lies inside or on the triangle a, b, c.
+func pointInTriangle(p, a, b, c vector2.Vector2) bool {
+	d1 := b.Sub(a).Cross(p.Sub(a))
+	d2 := c.Sub(b).Cross(p.Sub(b))
+	d3 := a.Sub(c).Cross(p.Sub(c))
+	hasNeg := d1 < 0 || d2 < 0 || d3 < 0
+	hasPos := d1 > 0 || d2 > 0 || d3 > 0
+	return !(hasNeg && hasPos)
+}
@@ -0,0 +1,89 @@
+package geometry2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// checkTriangulation checks that tris covers area with non-degenerate
+// triangles that all wind like want, and returns the triangles' centroids.
+func checkTriangulation(t *testing.T, points []vector2.Vector2, tris []int, area float64, clockwise bool) []vector2.Vector2 {
+	t.Helper()
+	if len(tris)%3 != 0 || len(tris) == 0 {
+		t.Fatalf("got %d indices, want a non-zero multiple of 3", len(tris))
+	}
+	total := 0.0
+	var centroids []vector2.Vector2
+	for i := 0; i < len(tris); i += 3 {
+		a, b, c := points[tris[i]], points[tris[i+1]], points[tris[i+2]]
+		signed := b.Sub(a).Cross(c.Sub(a)) / 2
+		if signed == 0 || (signed < 0) != clockwise {
+			t.Errorf("triangle %v %v %v has area %v", a, b, c, signed)
+		}
+		total += math.Abs(signed)
+		centroids = append(centroids, a.Add(b).Add(c).Divf(3))
+	}
+	if math.Abs(total-area) > 1e-9 {
+		t.Errorf("triangles cover %v, want %v", total, area)
+	}
+	return centroids
+}
+
+func TestGeometry2D_TriangulatePolygon(t *testing.T) {
+	// An L shape has a reflex corner that the first ear must avoid.
+	l := []vector2.Vector2{
+		vector2.New(0, 0), vector2.New(10, 0), vector2.New(10, 4),
+		vector2.New(4, 4), vector2.New(4, 10), vector2.New(0, 10),
+	}
+	tris := TriangulatePolygon(l)
+	if len(tris) != (len(l)-2)*3 {
+		t.Errorf("got %d triangles, want %d", len(tris)/3, len(l)-2)
+	}
+	checkTriangulation(t, l, tris, 64, false)
+
+	reversed := make([]vector2.Vector2, len(l))
+	for i, p := range l {
+		reversed[len(l)-1-i] = p
+	}
+	checkTriangulation(t, reversed, TriangulatePolygon(reversed), 64, true)
+
+	if tris := TriangulatePolygon(l[:2]); len(tris) != 0 {
+		t.Errorf("two points gave %v, want no triangles", tris)
+	}
+}
+
+func TestGeometry2D_TriangulatePolygonWithHoles(t *testing.T) {
+	outer := []vector2.Vector2{vector2.New(0, 0), vector2.New(10, 0), vector2.New(10, 10), vector2.New(0, 10)}
+	// The hole winds the same way as outer; it is reversed internally.
+	hole := []vector2.Vector2{vector2.New(3, 3), vector2.New(7, 3), vector2.New(7, 7), vector2.New(3, 7)}
+	points := append(append([]vector2.Vector2{}, outer...), hole...)
+
+	tris := TriangulatePolygonWithHoles(outer, [][]vector2.Vector2{hole})
+	// One bridge turns 8 vertices into a 10 vertex ring: 8 triangles.
+	if len(tris) != 8*3 {
+		t.Errorf("got %d triangles, want 8", len(tris)/3)
+	}
+	for _, c := range checkTriangulation(t, points, tris, 100-16, false) {
+		if c.X > 3 && c.X < 7 && c.Y > 3 && c.Y < 7 {
+			t.Errorf("triangle centroid %v lies inside the hole", c)
+		}
+	}
+	for _, i := range tris {
+		if i < 0 || i >= len(points) {
+			t.Fatalf("index %d out of range", i)
+		}
+	}
+
+	// Two holes side by side, the left one shadowed by the right one.
+	left := []vector2.Vector2{vector2.New(1, 4), vector2.New(3, 4), vector2.New(3, 6), vector2.New(1, 6)}
+	right := []vector2.Vector2{vector2.New(5, 4), vector2.New(8, 4), vector2.New(8, 6), vector2.New(5, 6)}
+	points = append(append(append([]vector2.Vector2{}, outer...), left...), right...)
+	tris = TriangulatePolygonWithHoles(outer, [][]vector2.Vector2{left, right})
+	checkTriangulation(t, points, tris, 100-4-6, false)
+
+	if tris := TriangulatePolygonWithHoles(outer, nil); len(tris) != 2*3 {
+		t.Errorf("no holes gave %d triangles, want 2", len(tris)/3)
+	}
+}
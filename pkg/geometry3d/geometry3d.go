@@ -0,0 +1,89 @@
+// Package geometry3d provides helpers for 3D geometry queries.
+package geometry3d
+
+/**************************************************************************/
+/*  geometry_3d.h                                                         */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/color"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// GetTriangleBarycentricCoords returns the barycentric coordinates of point
+// with respect to the triangle a, b, c: the weights of a, b and c, summing to
+// 1, whose weighted sum of the corners is point projected onto the plane of
+// the triangle. All weights are in [0, 1] exactly when the projection lies
+// inside the triangle. A degenerate triangle returns all zeros.
+func GetTriangleBarycentricCoords(point, a, b, c vector3.Vector3) [3]float64 {
+	v0, v1, v2 := b.Sub(a), c.Sub(a), point.Sub(a)
+	d00 := v0.Dot(v0)
+	d01 := v0.Dot(v1)
+	d11 := v1.Dot(v1)
+	d20 := v2.Dot(v0)
+	d21 := v2.Dot(v1)
+	denom := d00*d11 - d01*d01
+	if denom == 0 {
+		return [3]float64{}
+	}
+	v := (d11*d20 - d01*d21) / denom
+	w := (d00*d21 - d01*d20) / denom
+	return [3]float64{1 - v - w, v, w}
+}
+
+// Attribute is the set of vertex attribute types InterpolateTriangleAttribute
+// can blend.
+type Attribute interface {
+	float64 | vector2.Vector2 | vector3.Vector3 | color.Color
+}
+
+// InterpolateTriangleAttribute blends the attribute values a, b and c of a
+// triangle's corners by the barycentric weights bary, as returned by
+// GetTriangleBarycentricCoords, to get the value at a point on the triangle,
+// for example the UV, normal or color at a ray hit. Interpolated normals are
+// not renormalized.
+func InterpolateTriangleAttribute[T Attribute](bary [3]float64, a, b, c T) T {
+	var res any
+	switch a := any(a).(type) {
+	case float64:
+		res = a*bary[0] + any(b).(float64)*bary[1] + any(c).(float64)*bary[2]
+	case vector2.Vector2:
+		res = a.Mulf(bary[0]).Add(any(b).(vector2.Vector2).Mulf(bary[1])).Add(any(c).(vector2.Vector2).Mulf(bary[2]))
+	case vector3.Vector3:
+		res = a.Mulf(bary[0]).Add(any(b).(vector3.Vector3).Mulf(bary[1])).Add(any(c).(vector3.Vector3).Mulf(bary[2]))
+	case color.Color:
+		res = a.Mulf(bary[0]).Add(any(b).(color.Color).Mulf(bary[1])).Add(any(c).(color.Color).Mulf(bary[2]))
+	}
+	return res.(T)
+}
@@ -0,0 +1,83 @@
+package geometry3d
+
+import (
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/color"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+func TestGeometry3D_GetTriangleBarycentricCoords(t *testing.T) {
+	a, b, c := vector3.New(1, 0, 0), vector3.New(4, 0, 1), vector3.New(1, 3, 2)
+	for _, tt := range []struct {
+		point vector3.Vector3
+		want  [3]float64
+	}{
+		{a, [3]float64{1, 0, 0}},
+		{b, [3]float64{0, 1, 0}},
+		{c, [3]float64{0, 0, 1}},
+		{a.Add(b).Add(c).Divf(3), [3]float64{1.0 / 3, 1.0 / 3, 1.0 / 3}},
+		{a.Lerp(b, 0.5), [3]float64{0.5, 0.5, 0}},
+		// Outside the triangle a weight goes negative.
+		{a.Sub(b.Sub(a)), [3]float64{2, -1, 0}},
+	} {
+		got := GetTriangleBarycentricCoords(tt.point, a, b, c)
+		for i := range got {
+			if !zerogdscript.IsEqualApprox(got[i], tt.want[i]) {
+				t.Errorf("GetTriangleBarycentricCoords(%v) = %v, want %v", tt.point, got, tt.want)
+				break
+			}
+		}
+	}
+
+	// A point off the plane gets the coordinates of its projection.
+	n := b.Sub(a).Cross(c.Sub(a)).Normalized()
+	centroid := a.Add(b).Add(c).Divf(3)
+	got := GetTriangleBarycentricCoords(centroid.Add(n.Mulf(5)), a, b, c)
+	for i := range got {
+		if !zerogdscript.IsEqualApprox(got[i], 1.0/3) {
+			t.Errorf("off-plane centroid coords = %v, want 1/3 each", got)
+			break
+		}
+	}
+
+	if got := GetTriangleBarycentricCoords(a, a, a, b); got != [3]float64{} {
+		t.Errorf("degenerate triangle coords = %v, want zeros", got)
+	}
+}
+
+func TestGeometry3D_InterpolateTriangleAttribute(t *testing.T) {
+	centroid := [3]float64{1.0 / 3, 1.0 / 3, 1.0 / 3}
+	atB := [3]float64{0, 1, 0}
+
+	red, green, blue := color.New(1, 0, 0, 1), color.New(0, 1, 0, 1), color.New(0, 0, 1, 0.4)
+	if got, want := InterpolateTriangleAttribute(centroid, red, green, blue), color.New(1.0/3, 1.0/3, 1.0/3, 0.8); !got.IsEqualApprox(want) {
+		t.Errorf("color at centroid = %v, want the average %v", got, want)
+	}
+	if got := InterpolateTriangleAttribute(atB, red, green, blue); got != green {
+		t.Errorf("color at vertex b = %v, want exactly %v", got, green)
+	}
+
+	if got := InterpolateTriangleAttribute(centroid, 3.0, 6.0, 9.0); !zerogdscript.IsEqualApprox(got, 6) {
+		t.Errorf("float at centroid = %v, want 6", got)
+	}
+
+	uv := InterpolateTriangleAttribute([3]float64{0.5, 0.25, 0.25}, vector2.New(0, 0), vector2.New(1, 0), vector2.New(0, 1))
+	if want := vector2.New(0.25, 0.25); !uv.IsEqualApprox(want) {
+		t.Errorf("uv = %v, want %v", uv, want)
+	}
+
+	up, right := vector3.New(0, 1, 0), vector3.New(1, 0, 0)
+	if got := InterpolateTriangleAttribute([3]float64{1, 0, 0}, up, right, right); got != up {
+		t.Errorf("normal at vertex a = %v, want exactly %v", got, up)
+	}
+
+	// End to end: the color at a hit point.
+	a, b, c := vector3.New(0, 0, 0), vector3.New(2, 0, 0), vector3.New(0, 2, 0)
+	bary := GetTriangleBarycentricCoords(vector3.New(1, 1, 0), a, b, c)
+	if got, want := InterpolateTriangleAttribute(bary, red, green, blue), color.New(0, 0.5, 0.5, 0.7); !got.IsEqualApprox(want) {
+		t.Errorf("color on edge bc = %v, want %v", got, want)
+	}
+}
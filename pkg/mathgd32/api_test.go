@@ -0,0 +1,41 @@
+package mathgd32
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
+	"github.com/Anaxarchus/zero-gdscript/pkg/transform2d"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// TestAPI_Subset checks that every method of the float32 types also exists on
+// the float64 type with the same number of arguments and results, so the two
+// packages cannot drift apart silently. Float64 is the only extra method.
+func TestAPI_Subset(t *testing.T) {
+	pairs := []struct {
+		f32, f64 reflect.Type
+	}{
+		{reflect.TypeOf(&Vector2{}), reflect.TypeOf(&vector2.Vector2{})},
+		{reflect.TypeOf(&Vector3{}), reflect.TypeOf(&vector3.Vector3{})},
+		{reflect.TypeOf(&Basis{}), reflect.TypeOf(&basis.Basis{})},
+		{reflect.TypeOf(&Transform2D{}), reflect.TypeOf(&transform2d.Transform2D{})},
+	}
+	for _, p := range pairs {
+		for i := 0; i < p.f32.NumMethod(); i++ {
+			m := p.f32.Method(i)
+			if m.Name == "Float64" {
+				continue
+			}
+			other, ok := p.f64.MethodByName(m.Name)
+			if !ok {
+				t.Errorf("%v.%s has no float64 counterpart", p.f32.Elem(), m.Name)
+				continue
+			}
+			if m.Type.NumIn() != other.Type.NumIn() || m.Type.NumOut() != other.Type.NumOut() {
+				t.Errorf("%v.%s signature %v does not match float64 %v", p.f32.Elem(), m.Name, m.Type, other.Type)
+			}
+		}
+	}
+}
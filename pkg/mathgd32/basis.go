@@ -0,0 +1,171 @@
+package mathgd32
+
+/**************************************************************************/
+/*  basis.h                                                               */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/internal/core"
+)
+
+// Basis is the single-precision variant of basis.Basis.
+// Like basis.Basis it stores the matrix as rows, with the basis axes in the columns.
+type Basis struct {
+	Rows [3][3]float32
+}
+
+func NewBasis() Basis {
+	return Basis{
+		Rows: [3][3]float32{
+			{1, 0, 0},
+			{0, 1, 0},
+			{0, 0, 1},
+		},
+	}
+}
+
+func BasisFromAxisAndAngle(axis [3]float32, angle float32) Basis {
+	b := NewBasis()
+	b.SetAxisAngle(axis, angle)
+	return b
+}
+
+func (b *Basis) Set(pXX, pXY, pXZ, pYX, pYY, pYZ, pZX, pZY, pZZ float32) {
+	b.Rows[0] = [3]float32{pXX, pXY, pXZ}
+	b.Rows[1] = [3]float32{pYX, pYY, pYZ}
+	b.Rows[2] = [3]float32{pZX, pZY, pZZ}
+}
+
+// SetColumns sets the columns of the basis matrix.
+func (b *Basis) SetColumns(x, y, z [3]float32) {
+	b.SetColumn(0, x)
+	b.SetColumn(1, y)
+	b.SetColumn(2, z)
+}
+
+// GetColumn returns the specified column of the basis matrix.
+func (b Basis) GetColumn(index int) []float32 {
+	return []float32{b.Rows[0][index], b.Rows[1][index], b.Rows[2][index]}
+}
+
+// SetColumn sets the specified column of the basis matrix.
+func (b *Basis) SetColumn(index int, value [3]float32) {
+	b.Rows[0][index] = value[0]
+	b.Rows[1][index] = value[1]
+	b.Rows[2][index] = value[2]
+}
+
+// GetMainDiagonal returns the main diagonal of the basis matrix.
+func (b Basis) GetMainDiagonal() []float32 {
+	return []float32{b.Rows[0][0], b.Rows[1][1], b.Rows[2][2]}
+}
+
+// TransposeXform returns the result of transposing and multiplying the provided basis matrix with this basis matrix.
+func (b Basis) TransposeXform(m Basis) Basis {
+	var res Basis
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			res.Rows[i][j] = float32(b.Rows[0][i]*m.Rows[0][j]) + float32(b.Rows[1][i]*m.Rows[1][j]) + float32(b.Rows[2][i]*m.Rows[2][j])
+		}
+	}
+	return res
+}
+
+// Set the basis matrix to represent a rotation around the given axis by the specified angle.
+// A non-normalized axis is normalized first; a zero axis sets the identity.
+func (b *Basis) SetAxisAngle(axis [3]float32, angle float32) {
+	lsq := float32(axis[0]*axis[0]) + float32(axis[1]*axis[1]) + float32(axis[2]*axis[2])
+	if lsq == 0 {
+		*b = NewBasis()
+		return
+	}
+	if !IsEqualApprox(lsq, 1) {
+		l := sqrt32(lsq)
+		axis = [3]float32{axis[0] / l, axis[1] / l, axis[2] / l}
+	}
+	axisSq := [3]float32{float32(axis[0] * axis[0]), float32(axis[1] * axis[1]), float32(axis[2] * axis[2])}
+	sine, cosine := sincos32(angle)
+
+	b.Rows[0][0] = axisSq[0] + float32(cosine*(1.0-axisSq[0]))
+	b.Rows[1][1] = axisSq[1] + float32(cosine*(1.0-axisSq[1]))
+	b.Rows[2][2] = axisSq[2] + float32(cosine*(1.0-axisSq[2]))
+
+	t := 1 - cosine
+	xyzt := float32(axis[0] * axis[1] * t)
+	zyxs := float32(axis[2] * sine)
+	b.Rows[0][1] = xyzt - zyxs
+	b.Rows[1][0] = xyzt + zyxs
+
+	xyzt = float32(axis[0] * axis[2] * t)
+	zyxs = float32(axis[1] * sine)
+	b.Rows[0][2] = xyzt + zyxs
+	b.Rows[2][0] = xyzt - zyxs
+
+	xyzt = float32(axis[1] * axis[2] * t)
+	zyxs = float32(axis[0] * sine)
+	b.Rows[1][2] = xyzt - zyxs
+	b.Rows[2][1] = xyzt + zyxs
+}
+
+func (b Basis) Xform(pVector [3]float32) [3]float32 {
+	return [3]float32{
+		float32(b.Rows[0][0]*pVector[0]) + float32(b.Rows[0][1]*pVector[1]) + float32(b.Rows[0][2]*pVector[2]),
+		float32(b.Rows[1][0]*pVector[0]) + float32(b.Rows[1][1]*pVector[1]) + float32(b.Rows[1][2]*pVector[2]),
+		float32(b.Rows[2][0]*pVector[0]) + float32(b.Rows[2][1]*pVector[1]) + float32(b.Rows[2][2]*pVector[2]),
+	}
+}
+
+func (b *Basis) Determinant() float32 {
+	return float32(b.Rows[0][0]*(float32(b.Rows[1][1]*b.Rows[2][2])-float32(b.Rows[2][1]*b.Rows[1][2]))) -
+		float32(b.Rows[1][0]*(float32(b.Rows[0][1]*b.Rows[2][2])-float32(b.Rows[2][1]*b.Rows[0][2]))) +
+		float32(b.Rows[2][0]*(float32(b.Rows[0][1]*b.Rows[1][2])-float32(b.Rows[1][1]*b.Rows[0][2])))
+}
+
+// Invert inverts the Basis matrix.
+// It returns ErrSingular and leaves the basis unchanged if the determinant is zero.
+func (b *Basis) Invert() error {
+	adj, det := core.Adjugate(b.Rows)
+	if det == 0 {
+		return zerogdscript.ErrSingular
+	}
+
+	s := 1.0 / det
+	for i := range adj {
+		for j := range adj[i] {
+			adj[i][j] *= s
+		}
+	}
+	b.Rows = adj
+	return nil
+}
@@ -0,0 +1,61 @@
+package mathgd32
+
+import (
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
+)
+
+func checkBasis(t *testing.T, name string, got Basis, want basis.Basis) {
+	t.Helper()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if !IsEqualApprox(got.Rows[i][j], float32(want.Rows[i][j])) {
+				t.Errorf("%s = %v, want %v", name, got.Rows, want.Rows)
+				return
+			}
+		}
+	}
+}
+
+func TestBasis_SetAxisAngle(t *testing.T) {
+	axis := [3]float64{0, 0.6, 0.8}
+	want := basis.FromAxisAndAngle(axis, 1.2)
+	got := BasisFromAxisAndAngle([3]float32{0, 0.6, 0.8}, 1.2)
+	checkBasis(t, "BasisFromAxisAndAngle", got, want)
+
+	v := want.Xform([3]float64{1, 2, 3})
+	gv := got.Xform([3]float32{1, 2, 3})
+	for i := range v {
+		if !IsEqualApprox(gv[i], float32(v[i])) {
+			t.Errorf("Xform() = %v, want %v", gv, v)
+			break
+		}
+	}
+}
+
+func TestBasis_TransposeXform(t *testing.T) {
+	a := basis.FromAxisAndAngle([3]float64{1, 0, 0}, 0.3)
+	b := basis.FromAxisAndAngle([3]float64{0, 1, 0}, -0.9)
+	checkBasis(t, "TransposeXform", FromBasis(a).TransposeXform(FromBasis(b)), a.TransposeXform(b))
+}
+
+func TestBasis_Invert(t *testing.T) {
+	var b Basis
+	b.Set(2, 0, 1, 0, 3, 0, 1, 0, 1)
+	if got := b.Determinant(); !IsEqualApprox(got, 3) {
+		t.Errorf("Determinant() = %v, want 3", got)
+	}
+	if err := b.Invert(); err != nil {
+		t.Fatal(err)
+	}
+	var want basis.Basis
+	want.Set(1, 0, -1, 0, 1.0/3.0, 0, -1, 0, 2)
+	checkBasis(t, "Invert", b, want)
+
+	var singular Basis
+	singular.Set(1, 2, 3, 2, 4, 6, 0, 0, 1)
+	if err := singular.Invert(); err == nil {
+		t.Errorf("Invert() of a singular basis returned no error")
+	}
+}
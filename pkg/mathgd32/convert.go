@@ -0,0 +1,60 @@
+package mathgd32
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
+	"github.com/Anaxarchus/zero-gdscript/pkg/transform2d"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// FromVector2 converts a double-precision vector2.Vector2 to a Vector2.
+func FromVector2(v vector2.Vector2) Vector2 {
+	return NewVector2(float32(v.X), float32(v.Y))
+}
+
+// Float64 converts v to a double-precision vector2.Vector2.
+func (v Vector2) Float64() vector2.Vector2 {
+	return vector2.New(float64(v.X), float64(v.Y))
+}
+
+// FromVector3 converts a double-precision vector3.Vector3 to a Vector3.
+func FromVector3(v vector3.Vector3) Vector3 {
+	return NewVector3(float32(v.X), float32(v.Y), float32(v.Z))
+}
+
+// Float64 converts v to a double-precision vector3.Vector3.
+func (v Vector3) Float64() vector3.Vector3 {
+	return vector3.New(float64(v.X), float64(v.Y), float64(v.Z))
+}
+
+// FromBasis converts a double-precision basis.Basis to a Basis.
+func FromBasis(b basis.Basis) Basis {
+	var res Basis
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			res.Rows[i][j] = float32(b.Rows[i][j])
+		}
+	}
+	return res
+}
+
+// Float64 converts b to a double-precision basis.Basis.
+func (b Basis) Float64() basis.Basis {
+	var res basis.Basis
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			res.Rows[i][j] = float64(b.Rows[i][j])
+		}
+	}
+	return res
+}
+
+// FromTransform2D converts a double-precision transform2d.Transform2D to a Transform2D.
+func FromTransform2D(t transform2d.Transform2D) Transform2D {
+	return Transform2DFromColumns(FromVector2(t.Columns[0]), FromVector2(t.Columns[1]), FromVector2(t.Columns[2]))
+}
+
+// Float64 converts t to a double-precision transform2d.Transform2D.
+func (t Transform2D) Float64() transform2d.Transform2D {
+	return transform2d.Transform2DFromColumns(t.Columns[0].Float64(), t.Columns[1].Float64(), t.Columns[2].Float64())
+}
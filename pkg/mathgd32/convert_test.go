@@ -0,0 +1,78 @@
+package mathgd32
+
+import (
+	"math"
+	"testing"
+	"unsafe"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
+	"github.com/Anaxarchus/zero-gdscript/pkg/transform2d"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// singleTolerance is the relative error allowed by a float64 -> float32 -> float64 round trip.
+const singleTolerance = 1.0 / (1 << 23)
+
+func withinSingle(got, want float64) bool {
+	return math.Abs(got-want) <= singleTolerance*math.Max(1, math.Abs(want))
+}
+
+func TestConvert_Vector2(t *testing.T) {
+	v := vector2.New(1234.5678, -0.000123456)
+	got := FromVector2(v).Float64()
+	if !withinSingle(got.X, v.X) || !withinSingle(got.Y, v.Y) {
+		t.Errorf("round trip of %v = %v", v, got)
+	}
+}
+
+func TestConvert_Vector3(t *testing.T) {
+	v := vector3.New(1234.5678, -0.000123456, math.Pi)
+	got := FromVector3(v).Float64()
+	if !withinSingle(got.X, v.X) || !withinSingle(got.Y, v.Y) || !withinSingle(got.Z, v.Z) {
+		t.Errorf("round trip of %v = %v", v, got)
+	}
+}
+
+func TestConvert_Basis(t *testing.T) {
+	b := basis.FromAxisAndAngle([3]float64{0, 0.6, 0.8}, 1.2345)
+	got := FromBasis(b).Float64()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			if !withinSingle(got.Rows[i][j], b.Rows[i][j]) {
+				t.Fatalf("round trip of %v = %v", b.Rows, got.Rows)
+			}
+		}
+	}
+}
+
+func TestConvert_Transform2D(t *testing.T) {
+	tr := transform2d.NewTransform2D(0.987, vector2.New(-512.25, 1e4/3))
+	got := FromTransform2D(tr).Float64()
+	for i := range tr.Columns {
+		if !withinSingle(got.Columns[i].X, tr.Columns[i].X) || !withinSingle(got.Columns[i].Y, tr.Columns[i].Y) {
+			t.Fatalf("round trip of %v = %v", tr, got)
+		}
+	}
+}
+
+func TestConvert_Sizes(t *testing.T) {
+	sizes := []struct {
+		name      string
+		got, want uintptr
+	}{
+		{"Vector2", unsafe.Sizeof(Vector2{}), 8},
+		{"Vector3", unsafe.Sizeof(Vector3{}), 12},
+		{"Basis", unsafe.Sizeof(Basis{}), 36},
+		{"Transform2D", unsafe.Sizeof(Transform2D{}), 24},
+		{"vector2.Vector2", unsafe.Sizeof(vector2.Vector2{}), 16},
+		{"vector3.Vector3", unsafe.Sizeof(vector3.Vector3{}), 24},
+		{"basis.Basis", unsafe.Sizeof(basis.Basis{}), 72},
+		{"transform2d.Transform2D", unsafe.Sizeof(transform2d.Transform2D{}), 48},
+	}
+	for _, s := range sizes {
+		if s.got != s.want {
+			t.Errorf("unsafe.Sizeof(%s) = %d bytes, want %d", s.name, s.got, s.want)
+		}
+	}
+}
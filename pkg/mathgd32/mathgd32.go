@@ -0,0 +1,167 @@
+// Package mathgd32 is a single-precision (float32) variant of the core math
+// types, for memory-constrained code and for matching Godot builds that use
+// 32-bit real_t. Conversions to and from the float64 packages are provided by
+// the From* functions and the Float64 methods.
+//
+// The package covers a subset of the float64 API: construction, arithmetic,
+// length and normalization, distances and angles, rounding, snapping, posmod,
+// clamping, Lerp, rotation and IsFinite for Vector2 and Vector3, the
+// axis-angle, transpose, Xform, Determinant and Invert operations of Basis,
+// and construction, rotation, scale, inverses and Xform for Transform2D.
+// Every method present here has the same name and behaves the same as its
+// float64 counterpart apart from precision; TestAPI_Subset guards the names.
+//
+// Every intermediate product is converted to float32 explicitly so the
+// compiler cannot fuse it into a multiply-add, and results are bit for bit the
+// same on every architecture.
+package mathgd32
+
+/**************************************************************************/
+/*  math_funcs.h, math_defs.h                                             */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"math"
+
+	"github.com/Anaxarchus/zero-gdscript/internal/core"
+)
+
+// The tolerance constants are shared with the float64 packages through
+// internal/core, so both precisions agree on what counts as equal.
+const (
+	// CMP_EPSILON represents the tolerance value used for floating-point comparison.
+	CMP_EPSILON = core.CMP_EPSILON
+
+	// CMP_EPSILON2 represents the square of CMP_EPSILON.
+	CMP_EPSILON2 = core.CMP_EPSILON2
+
+	// CMP_NORMALIZE_TOLERANCE represents the tolerance value used for normalizing vectors.
+	CMP_NORMALIZE_TOLERANCE = core.CMP_NORMALIZE_TOLERANCE
+
+	// CMP_POINT_IN_PLANE_EPSILON represents the tolerance value used for checking if a point lies on a plane.
+	CMP_POINT_IN_PLANE_EPSILON = core.CMP_POINT_IN_PLANE_EPSILON
+
+	// UNIT_EPSILON represents the tolerance value used for checking unit length.
+	UNIT_EPSILON = core.UNIT_EPSILON
+
+	// TAU represents the mathematical constant Tau (2 * Pi).
+	TAU = core.TAU
+
+	// PI represents the mathematical constant Pi.
+	PI = core.PI
+)
+
+// IsFinitef reports whether x is neither NaN nor an infinity.
+func IsFinitef(x float32) bool {
+	return core.IsFinitef(x)
+}
+
+// IsZeroApprox checks if a floating-point number is approximately zero within a certain tolerance.
+// NaN and infinities are never approximately zero.
+func IsZeroApprox(x float32) bool {
+	return core.IsZeroApprox(x)
+}
+
+// IsEqualApprox checks if two floating-point numbers are approximately equal within a certain tolerance.
+// An infinity equals only the infinity of the same sign and NaN equals nothing, itself included.
+func IsEqualApprox(x, y float32) bool {
+	return core.IsEqualApprox(x, y)
+}
+
+// IsEqualApproxTol checks if x and y differ by less than the given absolute tolerance.
+func IsEqualApproxTol(x, y, tolerance float32) bool {
+	return core.IsEqualApproxTol(x, y, tolerance)
+}
+
+// IsEqualApproxRelative checks if x and y are approximately equal with a tolerance
+// of CMP_EPSILON scaled by abs(x), as Godot's is_equal_approx does.
+func IsEqualApproxRelative(x, y float32) bool {
+	return core.IsEqualApproxRelative(x, y)
+}
+
+// Sign returns the sign of a floating-point number.
+// It returns 1 if x is positive, -1 if x is negative, and 0 if x is zero.
+func Sign(x float32) float32 {
+	return core.Sign(x)
+}
+
+// Clampf clamps a value within a specified range.
+func Clampf(val, min, max float32) float32 {
+	return core.Clampf(val, min, max)
+}
+
+// Snapped returns the nearest value to 'from' that is a multiple of 'to',
+// rounding halfway values away from zero. A negative 'to' is treated as its
+// absolute value. If 'to' is zero, 'from' is returned unchanged, as in Godot.
+func Snapped(from, to float32) float32 {
+	return core.Snapped(from, to)
+}
+
+// Fposmod returns the positive floating-point modulus of x modulo y.
+func Fposmod(x, y float32) float32 {
+	return core.Fposmod(x, y)
+}
+
+// Lerp performs linear interpolation between two values.
+func Lerp(p_from, p_to, p_weight float32) float32 {
+	return core.Lerp(p_from, p_to, p_weight)
+}
+
+func abs32(x float32) float32 {
+	return math.Float32frombits(math.Float32bits(x) &^ (1 << 31))
+}
+
+func sqrt32(x float32) float32 {
+	return float32(math.Sqrt(float64(x)))
+}
+
+func round32(x float32) float32 {
+	return float32(math.Round(float64(x)))
+}
+
+func floor32(x float32) float32 {
+	return float32(math.Floor(float64(x)))
+}
+
+func ceil32(x float32) float32 {
+	return float32(math.Ceil(float64(x)))
+}
+
+func sincos32(x float32) (float32, float32) {
+	s, c := math.Sincos(float64(x))
+	return float32(s), float32(c)
+}
+
+func atan232(y, x float32) float32 {
+	return float32(math.Atan2(float64(y), float64(x)))
+}
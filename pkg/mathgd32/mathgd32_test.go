@@ -0,0 +1,107 @@
+package mathgd32
+
+import (
+	"math"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+)
+
+func TestMathgd32_IsZeroApprox(t *testing.T) {
+	if !IsZeroApprox(0.000005) {
+		t.Errorf("IsZeroApprox(0.000005) = false, want true")
+	}
+	if IsZeroApprox(0.00005) {
+		t.Errorf("IsZeroApprox(0.00005) = true, want false with the shared epsilon")
+	}
+}
+
+func TestMathgd32_IsEqualApprox(t *testing.T) {
+	// 0.1+0.2 in float32 differs from 0.3 by more than float32 rounding but far less than CMP_EPSILON.
+	if !IsEqualApprox(float32(0.1)+float32(0.2), 0.3) {
+		t.Errorf("IsEqualApprox(0.1+0.2, 0.3) = false, want true")
+	}
+	if IsEqualApprox(1, 1.001) {
+		t.Errorf("IsEqualApprox(1, 1.001) = true, want false")
+	}
+}
+
+func TestMathgd32_Epsilon(t *testing.T) {
+	if CMP_EPSILON != zerogdscript.CMP_EPSILON {
+		t.Errorf("CMP_EPSILON = %v, want the shared %v", CMP_EPSILON, zerogdscript.CMP_EPSILON)
+	}
+}
+
+func TestMathgd32_Sign(t *testing.T) {
+	for _, x := range []float32{-2.5, 0, 3} {
+		if got, want := Sign(x), float32(zerogdscript.Sign(float64(x))); got != want {
+			t.Errorf("Sign(%v) = %v, want %v", x, got, want)
+		}
+	}
+}
+
+func TestMathgd32_Clampf(t *testing.T) {
+	if got := Clampf(5, 0, 1); got != 1 {
+		t.Errorf("Clampf(5, 0, 1) = %v, want 1", got)
+	}
+	if got := Clampf(-5, 0, 1); got != 0 {
+		t.Errorf("Clampf(-5, 0, 1) = %v, want 0", got)
+	}
+}
+
+func TestMathgd32_Snapped(t *testing.T) {
+	for _, c := range [][2]float32{{1.26, 0.25}, {-3.7, 0.5}, {10, 3}} {
+		got := Snapped(c[0], c[1])
+		want := float32(zerogdscript.Snapped(float64(c[0]), float64(c[1])))
+		if !IsEqualApprox(got, want) {
+			t.Errorf("Snapped(%v, %v) = %v, want %v", c[0], c[1], got, want)
+		}
+	}
+}
+
+func TestMathgd32_Fposmod(t *testing.T) {
+	if got := Fposmod(-1, 3); got != 2 {
+		t.Errorf("Fposmod(-1, 3) = %v, want 2", got)
+	}
+}
+
+func TestMathgd32_Lerp(t *testing.T) {
+	if got := Lerp(2, 4, 0.25); got != 2.5 {
+		t.Errorf("Lerp(2, 4, 0.25) = %v, want 2.5", got)
+	}
+}
+
+// TestMathgd32_BitExact pins results computed with every product rounded to
+// float32 before it is added. A fused multiply-add gives different bits for
+// Dot and LengthSquared here, so this fails on a platform that fuses them.
+func TestMathgd32_BitExact(t *testing.T) {
+	check := func(name string, got float32, want uint32) {
+		t.Helper()
+		if math.Float32bits(got) != want {
+			t.Errorf("%s = %v (%#08x), want %v (%#08x)", name, got, math.Float32bits(got), math.Float32frombits(want), want)
+		}
+	}
+
+	a := NewVector3(1.1, 2.2, 3.3)
+	b := NewVector3(4.4, -5.5, 6.6)
+	check("Dot", a.Dot(b), 0x416851ea)
+	check("LengthSquared", a.LengthSquared(), 0x4187851e)
+	check("Length", a.Length(), 0x4083b4d2)
+	c := a.Cross(b)
+	check("Cross.X", c.X, 0x4202ae14)
+	check("Cross.Y", c.Y, 0x40e851ec)
+	check("Cross.Z", c.Z, 0xc17bae15)
+	check("Lerp", Lerp(1.1, 7.3, 0.37), 0x4059374c)
+
+	basis := Basis{Rows: [3][3]float32{{0.3, -1.7, 2.9}, {1.3, 0.7, -0.9}, {-2.1, 0.4, 1.1}}}
+	x := basis.Xform([3]float32{a.X, a.Y, a.Z})
+	check("Basis.Xform[0]", x[0], 0x40c51eb9)
+	check("Basis.Xform[1]", x[1], 0x00000000)
+	check("Basis.Xform[2]", x[2], 0x400cccce)
+	check("Basis.Determinant", basis.Determinant(), 0x40aa7efa)
+
+	tr := Transform2DFromCells(0.3, 1.3, -1.7, 0.7, 2.9, -0.9)
+	p := tr.Xform(NewVector2(a.X, a.Y))
+	check("Transform2D.Xform.X", p.X, 0xbf028f60)
+	check("Transform2D.Xform.Y", p.Y, 0x40047ae0)
+}
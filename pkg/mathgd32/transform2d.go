@@ -0,0 +1,186 @@
+package mathgd32
+
+/**************************************************************************/
+/*  transform_2d.h                                                        */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import zerogdscript "github.com/Anaxarchus/zero-gdscript"
+
+// Transform2D is the single-precision variant of transform2d.Transform2D.
+type Transform2D struct {
+	Columns [3]Vector2 // A 3x2 matrix, using Vector2 for each column
+}
+
+// NewTransform2D creates a new Transform2D given a rotation and a translation vector.
+func NewTransform2D(rot float32, pos Vector2) Transform2D {
+	sr, cr := sincos32(rot)
+	return Transform2D{
+		Columns: [3]Vector2{
+			NewVector2(cr, sr),
+			NewVector2(-sr, cr),
+			pos,
+		},
+	}
+}
+
+func Transform2DFromCells(xx, xy, yx, yy, ox, oy float32) Transform2D {
+	return Transform2D{
+		Columns: [3]Vector2{
+			NewVector2(xx, xy),
+			NewVector2(yx, yy),
+			NewVector2(ox, oy),
+		},
+	}
+}
+
+func Transform2DFromColumns(x, y, origin Vector2) Transform2D {
+	return Transform2D{
+		Columns: [3]Vector2{x, y, origin},
+	}
+}
+
+func (t *Transform2D) GetRotation() float32 {
+	return atan232(t.Columns[0].Y, t.Columns[0].X)
+}
+
+func (t *Transform2D) SetRotation(p_rot float32) {
+	scale := t.GetScale()
+	sr, cr := sincos32(p_rot)
+	t.Columns[0].X = cr
+	t.Columns[0].Y = sr
+	t.Columns[1].X = -sr
+	t.Columns[1].Y = cr
+	t.SetScale(scale)
+}
+
+func (t *Transform2D) GetScale() Vector2 {
+	detSign := Sign(t.determinant())
+	return NewVector2(t.Columns[0].Length(), detSign*t.Columns[1].Length())
+}
+
+func (t *Transform2D) SetScale(p_scale Vector2) {
+	t.Columns[0].Normalize()
+	t.Columns[1].Normalize()
+	t.Columns[0] = t.Columns[0].Mulf(p_scale.X)
+	t.Columns[1] = t.Columns[1].Mulf(p_scale.Y)
+}
+
+func (t Transform2D) Translated(p_offset Vector2) Transform2D {
+	// Equivalent to left multiplication
+	return Transform2DFromColumns(t.Columns[0], t.Columns[1], t.Columns[2].Add(p_offset))
+}
+
+// ToLocal converts a point from global space to local space.
+func (t Transform2D) ToLocal(point Vector2) Vector2 {
+	return t.AffineInverse().Xform(point)
+}
+
+// ToGlobal converts a point from local space to global space.
+func (t Transform2D) ToGlobal(point Vector2) Vector2 {
+	return t.Xform(point)
+}
+
+// Inverse returns the inverse of the current transformation if it's a pure rotation.
+// It transposes the basis, so it never fails but is wrong under scale; use
+// AffineInverse for those.
+func (t Transform2D) Inverse() Transform2D {
+	// This assumes the matrix is a rotation matrix (no scaling).
+	inv := Transform2D{
+		Columns: [3]Vector2{
+			NewVector2(t.Columns[0].X, t.Columns[1].X),
+			NewVector2(t.Columns[0].Y, t.Columns[1].Y),
+		},
+	}
+	inv.Columns[2] = inv.basisXform(t.Columns[2].Mulf(-1))
+	return inv
+}
+
+// Invert inverts the transformation in place, assuming a pure rotation. See Inverse.
+func (t *Transform2D) Invert() {
+	*t = t.Inverse()
+}
+
+// AffineInverse computes the matrix inverse handling potential scalings.
+// A singular transformation returns the zero Transform2D; use AffineInvert to
+// detect that case.
+func (t Transform2D) AffineInverse() Transform2D {
+	det := t.determinant()
+	if det == 0 {
+		return Transform2D{}
+	}
+	idet := 1.0 / det
+
+	inv := Transform2D{
+		Columns: [3]Vector2{
+			NewVector2(t.Columns[1].Y*idet, -t.Columns[0].Y*idet),
+			NewVector2(-t.Columns[1].X*idet, t.Columns[0].X*idet),
+		},
+	}
+	inv.Columns[2] = inv.basisXform(t.Columns[2].Mulf(-1))
+	return inv
+}
+
+// AffineInvert inverts the transformation in place, handling potential scalings.
+// It returns ErrSingular and leaves the transformation unchanged if the determinant is zero.
+func (t *Transform2D) AffineInvert() error {
+	if t.determinant() == 0 {
+		return zerogdscript.ErrSingular
+	}
+	*t = t.AffineInverse()
+	return nil
+}
+
+// Xform applies the transformation to a vector.
+func (t Transform2D) Xform(vec Vector2) Vector2 {
+	return NewVector2(t.tdotx(vec), t.tdoty(vec)).Add(t.Columns[2])
+}
+
+// basisXform applies only the basis of the transformation to a vector.
+func (t Transform2D) basisXform(v Vector2) Vector2 {
+	return NewVector2(t.tdotx(v), t.tdoty(v))
+}
+
+// tdotx calculates the dot product with the x-axis of the transformation.
+func (t Transform2D) tdotx(v Vector2) float32 {
+	return float32(t.Columns[0].X*v.X) + float32(t.Columns[1].X*v.Y)
+}
+
+// tdoty calculates the dot product with the y-axis of the transformation.
+func (t Transform2D) tdoty(v Vector2) float32 {
+	return float32(t.Columns[0].Y*v.X) + float32(t.Columns[1].Y*v.Y)
+}
+
+// determinant calculates the determinant of the transformation.
+func (t Transform2D) determinant() float32 {
+	return float32(t.Columns[0].X*t.Columns[1].Y) - float32(t.Columns[1].X*t.Columns[0].Y)
+}
@@ -0,0 +1,50 @@
+package mathgd32
+
+import (
+	"errors"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+func TestTransform2D_Rotation(t *testing.T) {
+	for _, r := range []float32{0, 0.6, -1.2, 3} {
+		tr := NewTransform2D(r, NewVector2(3, -1))
+		checkScalar(t, "GetRotation", tr.GetRotation(), float64(r))
+
+		set := Transform2DFromCells(2, 0, 0, 2, 0, 0)
+		set.SetRotation(r)
+		checkScalar(t, "SetRotation", set.GetRotation(), float64(r))
+	}
+	checkVector2(t, "Xform", NewTransform2D(PI/2, NewVector2(3, -1)).Xform(NewVector2(1, 0)), vector2.New(3, 0))
+}
+
+func TestTransform2D_Inverse(t *testing.T) {
+	tr := NewTransform2D(0.6, NewVector2(3, -1))
+	p := NewVector2(2, 5)
+	checkVector2(t, "Inverse().Xform(Xform(p))", tr.Inverse().Xform(tr.Xform(p)), p.Float64())
+	checkVector2(t, "ToLocal(Xform(p))", tr.ToLocal(tr.Xform(p)), p.Float64())
+}
+
+func TestTransform2D_AffineInverse(t *testing.T) {
+	tr := Transform2DFromCells(2, 0.5, -1, 3, 4, 5)
+	p := NewVector2(2, 5)
+	checkVector2(t, "AffineInverse().Xform(Xform(p))", tr.AffineInverse().Xform(tr.Xform(p)), p.Float64())
+	checkVector2(t, "Xform(AffineInverse().Xform(p))", tr.Xform(tr.AffineInverse().Xform(p)), p.Float64())
+	scaled := Transform2DFromCells(2, 0, 0, 4, 1, 1).AffineInverse()
+	checkVector2(t, "GetScale", scaled.GetScale(), vector2.New(0.5, 0.25))
+}
+
+func TestTransform2D_AffineInvert(t *testing.T) {
+	singular := Transform2DFromCells(1, 2, 2, 4, 5, 6)
+	if err := singular.AffineInvert(); !errors.Is(err, zerogdscript.ErrSingular) {
+		t.Errorf("AffineInvert() of a singular transform = %v, want ErrSingular", err)
+	}
+	tr := Transform2DFromCells(2, 0.5, -1, 3, 4, 5)
+	want := tr.AffineInverse()
+	if err := tr.AffineInvert(); err != nil {
+		t.Fatalf("AffineInvert() = %v", err)
+	}
+	checkVector2(t, "AffineInvert origin", tr.Columns[2], want.Columns[2].Float64())
+}
@@ -0,0 +1,254 @@
+package mathgd32
+
+/**************************************************************************/
+/*  vector2.h                                                             */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+// Vector2 is the single-precision variant of vector2.Vector2.
+type Vector2 struct {
+	X float32 `json:"x"`
+	Y float32 `json:"y"`
+}
+
+func NewVector2(x, y float32) Vector2 {
+	return Vector2{X: x, Y: y}
+}
+
+func Vector2Zero() Vector2 {
+	return NewVector2(0, 0)
+}
+
+func Vector2One() Vector2 {
+	return NewVector2(1, 1)
+}
+
+func (v Vector2) Add(b Vector2) Vector2 {
+	v.X += b.X
+	v.Y += b.Y
+	return v
+}
+
+func (v Vector2) Sub(b Vector2) Vector2 {
+	v.X -= b.X
+	v.Y -= b.Y
+	return v
+}
+
+func (v Vector2) Mul(b Vector2) Vector2 {
+	v.X *= b.X
+	v.Y *= b.Y
+	return v
+}
+
+// Div divides v by b component-wise. Division by zero follows IEEE-754.
+func (v Vector2) Div(b Vector2) Vector2 {
+	v.X /= b.X
+	v.Y /= b.Y
+	return v
+}
+
+func (v Vector2) Addf(s float32) Vector2 {
+	v.X += s
+	v.Y += s
+	return v
+}
+
+func (v Vector2) Subf(s float32) Vector2 {
+	v.X -= s
+	v.Y -= s
+	return v
+}
+
+func (v Vector2) Mulf(s float32) Vector2 {
+	v.X *= s
+	v.Y *= s
+	return v
+}
+
+// Divf divides v by s. Division by zero follows IEEE-754.
+func (v Vector2) Divf(s float32) Vector2 {
+	v.X /= s
+	v.Y /= s
+	return v
+}
+
+func (v Vector2) Lerp(to Vector2, weight float32) Vector2 {
+	v.X = Lerp(v.X, to.X, weight)
+	v.Y = Lerp(v.Y, to.Y, weight)
+	return v
+}
+
+func (v Vector2) Angle() float32 {
+	return atan232(v.Y, v.X)
+}
+
+func (v Vector2) Length() float32 {
+	return sqrt32(float32(v.X*v.X) + float32(v.Y*v.Y))
+}
+
+func (v Vector2) LengthSquared() float32 {
+	return float32(v.X*v.X) + float32(v.Y*v.Y)
+}
+
+func (v *Vector2) Normalize() {
+	l := float32(v.X*v.X) + float32(v.Y*v.Y)
+	if l != 0 {
+		l = sqrt32(l)
+		v.X /= l
+		v.Y /= l
+	}
+}
+
+func (v Vector2) Normalized() Vector2 {
+	v.Normalize()
+	return v
+}
+
+func (v Vector2) IsNormalized() bool {
+	// use length_squared() instead of length() to avoid sqrt(), makes it more stringent.
+	return IsEqualApprox(v.LengthSquared(), 1)
+}
+
+func (v Vector2) DistanceTo(b Vector2) float32 {
+	return b.Sub(v).Length()
+}
+
+func (v Vector2) DistanceSquaredTo(b Vector2) float32 {
+	return b.Sub(v).LengthSquared()
+}
+
+func (v Vector2) DirectionTo(p_to Vector2) Vector2 {
+	return p_to.Sub(v).Normalized()
+}
+
+func (v Vector2) AngleTo(b Vector2) float32 {
+	return atan232(v.Cross(b), v.Dot(b))
+}
+
+func (v Vector2) Dot(b Vector2) float32 {
+	return float32(v.X*b.X) + float32(v.Y*b.Y)
+}
+
+func (v Vector2) Cross(b Vector2) float32 {
+	return float32(v.X*b.Y) - float32(v.Y*b.X)
+}
+
+func (v Vector2) Abs() Vector2 {
+	v.X = abs32(v.X)
+	v.Y = abs32(v.Y)
+	return v
+}
+
+func (v Vector2) Sign() Vector2 {
+	v.X = Sign(v.X)
+	v.Y = Sign(v.Y)
+	return v
+}
+
+func (v Vector2) Floor() Vector2 {
+	v.X = floor32(v.X)
+	v.Y = floor32(v.Y)
+	return v
+}
+
+func (v Vector2) Ceil() Vector2 {
+	v.X = ceil32(v.X)
+	v.Y = ceil32(v.Y)
+	return v
+}
+
+func (v Vector2) Round() Vector2 {
+	v.X = round32(v.X)
+	v.Y = round32(v.Y)
+	return v
+}
+
+func (v Vector2) Rotated(angle float32) Vector2 {
+	sine, cosi := sincos32(angle)
+	return NewVector2(float32(v.X*cosi)-float32(v.Y*sine), float32(v.X*sine)+float32(v.Y*cosi))
+}
+
+func (v Vector2) Posmod(x float32) Vector2 {
+	v.X = Fposmod(v.X, x)
+	v.Y = Fposmod(v.Y, x)
+	return v
+}
+
+func (v Vector2) Posmodv(b Vector2) Vector2 {
+	v.X = Fposmod(v.X, b.X)
+	v.Y = Fposmod(v.Y, b.Y)
+	return v
+}
+
+func (v Vector2) Clampi(min, max Vector2) Vector2 {
+	v.X = Clampf(v.X, min.X, max.X)
+	v.Y = Clampf(v.Y, min.Y, max.Y)
+	return v
+}
+
+func (v Vector2) Clampf(min, max float32) Vector2 {
+	v.X = Clampf(v.X, min, max)
+	v.Y = Clampf(v.Y, min, max)
+	return v
+}
+
+func (v Vector2) Snapped(to Vector2) Vector2 {
+	v.X = Snapped(v.X, to.X)
+	v.Y = Snapped(v.Y, to.Y)
+	return v
+}
+
+func (v Vector2) Snappedf(to float32) Vector2 {
+	v.X = Snapped(v.X, to)
+	v.Y = Snapped(v.Y, to)
+	return v
+}
+
+func (v Vector2) IsEqualApprox(b Vector2) bool {
+	return v.IsEqualApproxTol(b, CMP_EPSILON)
+}
+
+// IsEqualApproxTol reports whether each component of v differs from b by less than tolerance.
+func (v Vector2) IsEqualApproxTol(b Vector2, tolerance float32) bool {
+	return IsEqualApproxTol(v.X, b.X, tolerance) && IsEqualApproxTol(v.Y, b.Y, tolerance)
+}
+
+func (v Vector2) IsZeroApprox() bool {
+	return IsZeroApprox(v.X) && IsZeroApprox(v.Y)
+}
+
+// IsFinite reports whether neither component is NaN or an infinity of
+// either sign.
+func (v Vector2) IsFinite() bool {
+	return IsFinitef(v.X) && IsFinitef(v.Y)
+}
@@ -0,0 +1,107 @@
+package mathgd32
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// The Vector2 tests run each operation in single and double precision and
+// require the results to agree within CMP_EPSILON.
+
+var v2a, v2b = vector2.New(1.5, -2.25), vector2.New(-0.5, 4)
+
+func checkVector2(t *testing.T, name string, got Vector2, want vector2.Vector2) {
+	t.Helper()
+	if !got.IsEqualApprox(FromVector2(want)) {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
+
+func checkScalar(t *testing.T, name string, got float32, want float64) {
+	t.Helper()
+	if !IsEqualApprox(got, float32(want)) {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
+
+func TestVector2_Arithmetic(t *testing.T) {
+	a, b := FromVector2(v2a), FromVector2(v2b)
+	checkVector2(t, "Add", a.Add(b), v2a.Add(v2b))
+	checkVector2(t, "Sub", a.Sub(b), v2a.Sub(v2b))
+	checkVector2(t, "Mul", a.Mul(b), v2a.Mul(v2b))
+	checkVector2(t, "Div", a.Div(b), v2a.Div(v2b))
+	checkVector2(t, "Addf", a.Addf(2), v2a.Addf(2))
+	checkVector2(t, "Subf", a.Subf(2), v2a.Subf(2))
+	checkVector2(t, "Mulf", a.Mulf(2), v2a.Mulf(2))
+	checkVector2(t, "Divf", a.Divf(2), v2a.Divf(2))
+	checkVector2(t, "Lerp", a.Lerp(b, 0.3), v2a.Lerp(v2b, 0.3))
+}
+
+func TestVector2_Length(t *testing.T) {
+	a, b := FromVector2(v2a), FromVector2(v2b)
+	checkScalar(t, "Length", a.Length(), v2a.Length())
+	checkScalar(t, "LengthSquared", a.LengthSquared(), v2a.LengthSquared())
+	checkScalar(t, "DistanceTo", a.DistanceTo(b), v2a.DistanceTo(v2b))
+	checkScalar(t, "DistanceSquaredTo", a.DistanceSquaredTo(b), v2a.DistanceSquaredTo(v2b))
+	checkVector2(t, "Normalized", a.Normalized(), v2a.Normalized())
+	checkVector2(t, "DirectionTo", a.DirectionTo(b), v2a.DirectionTo(v2b))
+	if !a.Normalized().IsNormalized() {
+		t.Errorf("Normalized().IsNormalized() = false")
+	}
+}
+
+func TestVector2_Angles(t *testing.T) {
+	a, b := FromVector2(v2a), FromVector2(v2b)
+	checkScalar(t, "Angle", a.Angle(), v2a.Angle())
+	checkScalar(t, "AngleTo", a.AngleTo(b), v2a.AngleTo(v2b))
+	checkScalar(t, "Dot", a.Dot(b), v2a.Dot(v2b))
+	checkScalar(t, "Cross", a.Cross(b), v2a.Cross(v2b))
+	checkVector2(t, "Rotated", NewVector2(1, 0).Rotated(PI/2), vector2.New(0, 1))
+}
+
+func TestVector2_Rounding(t *testing.T) {
+	a := FromVector2(v2a)
+	checkVector2(t, "Abs", a.Abs(), v2a.Abs())
+	checkVector2(t, "Sign", a.Sign(), v2a.Sign())
+	checkVector2(t, "Floor", a.Floor(), v2a.Floor())
+	checkVector2(t, "Ceil", a.Ceil(), v2a.Ceil())
+	checkVector2(t, "Round", a.Round(), v2a.Round())
+	checkVector2(t, "Posmod", a.Posmod(2), v2a.Posmod(2))
+	checkVector2(t, "Clampf", a.Clampf(-1, 1), v2a.Clampf(-1, 1))
+	checkVector2(t, "Snappedf", a.Snappedf(0.5), v2a.Snappedf(0.5))
+	checkVector2(t, "Snapped", a.Snapped(NewVector2(0.5, 2)), v2a.Snapped(vector2.New(0.5, 2)))
+	checkVector2(t, "Posmodv", a.Posmodv(NewVector2(1, 3)), v2a.Posmodv(vector2.New(1, 3)))
+	checkVector2(t, "Clampi", a.Clampi(NewVector2(0, -1), NewVector2(1, 1)), v2a.Clampi(vector2.New(0, -1), vector2.New(1, 1)))
+}
+
+func TestVector2_IsFinite(t *testing.T) {
+	if !NewVector2(1.5, -3e38).IsFinite() {
+		t.Errorf("IsFinite() = false for a finite vector")
+	}
+	inf := float32(math.Inf(1))
+	for _, bad := range []float32{inf, -inf, float32(math.NaN())} {
+		if v := NewVector2(bad, 0); v.IsFinite() {
+			t.Errorf("%v.IsFinite() = true, want false", v)
+		}
+		if v := NewVector2(0, bad); v.IsFinite() {
+			t.Errorf("%v.IsFinite() = true, want false", v)
+		}
+	}
+	// Float32 overflow is caught too.
+	if FromVector2(v2a).Mulf(3e38).IsFinite() {
+		t.Errorf("IsFinite() = true after overflowing float32")
+	}
+}
+
+func TestVector2_DivByZero(t *testing.T) {
+	q := NewVector2(-1, 0).Divf(0)
+	if !math.IsInf(float64(q.X), -1) || !math.IsNaN(float64(q.Y)) {
+		t.Errorf("Divf(0) = %v, want (-Inf, NaN) like the float64 package", q)
+	}
+	r := NewVector3(-1, 1, 0).Div(Vector3Zero())
+	if !math.IsInf(float64(r.X), -1) || !math.IsInf(float64(r.Y), 1) || !math.IsNaN(float64(r.Z)) {
+		t.Errorf("Div(Zero) = %v, want (-Inf, +Inf, NaN) like the float64 package", r)
+	}
+}
@@ -0,0 +1,206 @@
+package mathgd32
+
+/**************************************************************************/
+/*  vector3.h                                                             */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+// Vector3 is the single-precision variant of vector3.Vector3.
+type Vector3 struct {
+	X float32 `json:"x"`
+	Y float32 `json:"y"`
+	Z float32 `json:"z"`
+}
+
+func NewVector3(x, y, z float32) Vector3 {
+	return Vector3{X: x, Y: y, Z: z}
+}
+
+func Vector3Zero() Vector3 {
+	return NewVector3(0, 0, 0)
+}
+
+func Vector3One() Vector3 {
+	return NewVector3(1, 1, 1)
+}
+
+func (v Vector3) Add(with Vector3) Vector3 {
+	return NewVector3(v.X+with.X, v.Y+with.Y, v.Z+with.Z)
+}
+
+func (v Vector3) Addf(with float32) Vector3 {
+	return NewVector3(v.X+with, v.Y+with, v.Z+with)
+}
+
+func (v Vector3) Sub(with Vector3) Vector3 {
+	return NewVector3(v.X-with.X, v.Y-with.Y, v.Z-with.Z)
+}
+
+func (v Vector3) Subf(with float32) Vector3 {
+	return NewVector3(v.X-with, v.Y-with, v.Z-with)
+}
+
+func (v Vector3) Mul(with Vector3) Vector3 {
+	return NewVector3(v.X*with.X, v.Y*with.Y, v.Z*with.Z)
+}
+
+func (v Vector3) Mulf(with float32) Vector3 {
+	return NewVector3(v.X*with, v.Y*with, v.Z*with)
+}
+
+// Div divides v by with component-wise. Division by zero follows IEEE-754.
+func (v Vector3) Div(with Vector3) Vector3 {
+	return NewVector3(v.X/with.X, v.Y/with.Y, v.Z/with.Z)
+}
+
+// Divf divides v by with. Division by zero follows IEEE-754.
+func (v Vector3) Divf(with float32) Vector3 {
+	return NewVector3(v.X/with, v.Y/with, v.Z/with)
+}
+
+func (v Vector3) Cross(with Vector3) Vector3 {
+	return NewVector3(
+		float32(v.Y*with.Z)-float32(v.Z*with.Y),
+		float32(v.Z*with.X)-float32(v.X*with.Z),
+		float32(v.X*with.Y)-float32(v.Y*with.X),
+	)
+}
+
+func (v Vector3) Dot(with Vector3) float32 {
+	return float32(v.X*with.X) + float32(v.Y*with.Y) + float32(v.Z*with.Z)
+}
+
+func (v Vector3) Abs() Vector3 {
+	return NewVector3(abs32(v.X), abs32(v.Y), abs32(v.Z))
+}
+
+func (v Vector3) Sign() Vector3 {
+	return NewVector3(Sign(v.X), Sign(v.Y), Sign(v.Z))
+}
+
+func (v Vector3) Floor() Vector3 {
+	return NewVector3(floor32(v.X), floor32(v.Y), floor32(v.Z))
+}
+
+func (v Vector3) Ceil() Vector3 {
+	return NewVector3(ceil32(v.X), ceil32(v.Y), ceil32(v.Z))
+}
+
+func (v Vector3) Round() Vector3 {
+	return NewVector3(round32(v.X), round32(v.Y), round32(v.Z))
+}
+
+func (v Vector3) Lerp(to Vector3, weight float32) Vector3 {
+	return NewVector3(
+		Lerp(v.X, to.X, weight),
+		Lerp(v.Y, to.Y, weight),
+		Lerp(v.Z, to.Z, weight),
+	)
+}
+
+func (v Vector3) DistanceTo(to Vector3) float32 {
+	return to.Sub(v).Length()
+}
+
+func (v Vector3) DistanceSquaredTo(to Vector3) float32 {
+	return to.Sub(v).LengthSquared()
+}
+
+func (v Vector3) Posmod(mod float32) Vector3 {
+	return NewVector3(Fposmod(v.X, mod), Fposmod(v.Y, mod), Fposmod(v.Z, mod))
+}
+
+func (v Vector3) Posmodv(modv Vector3) Vector3 {
+	return NewVector3(Fposmod(v.X, modv.X), Fposmod(v.Y, modv.Y), Fposmod(v.Z, modv.Z))
+}
+
+func (v Vector3) Project(to Vector3) Vector3 {
+	return to.Mulf(v.Dot(to) / to.LengthSquared())
+}
+
+func (v Vector3) AngleTo(to Vector3) float32 {
+	return atan232(v.Cross(to).Length(), v.Dot(to))
+}
+
+func (v Vector3) DirectionTo(to Vector3) Vector3 {
+	return to.Sub(v).Normalized()
+}
+
+func (v Vector3) Length() float32 {
+	return sqrt32(float32(v.X*v.X) + float32(v.Y*v.Y) + float32(v.Z*v.Z))
+}
+
+func (v Vector3) LengthSquared() float32 {
+	return float32(v.X*v.X) + float32(v.Y*v.Y) + float32(v.Z*v.Z)
+}
+
+func (v *Vector3) Normalize() {
+	lengthsq := v.LengthSquared()
+	if lengthsq == 0 {
+		*v = Vector3{}
+	} else {
+		length := sqrt32(lengthsq)
+		v.X /= length
+		v.Y /= length
+		v.Z /= length
+	}
+}
+
+func (v Vector3) Normalized() Vector3 {
+	v.Normalize()
+	return v
+}
+
+func (v Vector3) IsNormalized() bool {
+	// use length_squared() instead of length() to avoid sqrt(), makes it more stringent.
+	return IsEqualApprox(v.LengthSquared(), 1.0)
+}
+
+func (v Vector3) IsEqualApprox(b Vector3) bool {
+	return v.IsEqualApproxTol(b, CMP_EPSILON)
+}
+
+// IsEqualApproxTol reports whether each component of v differs from b by less than tolerance.
+func (v Vector3) IsEqualApproxTol(b Vector3, tolerance float32) bool {
+	return IsEqualApproxTol(v.X, b.X, tolerance) && IsEqualApproxTol(v.Y, b.Y, tolerance) && IsEqualApproxTol(v.Z, b.Z, tolerance)
+}
+
+// IsFinite reports whether no component is NaN or an infinity of either sign.
+func (v Vector3) IsFinite() bool {
+	return IsFinitef(v.X) && IsFinitef(v.Y) && IsFinitef(v.Z)
+}
+
+// Rotated returns the vector rotated around the normalized axis by angle radians.
+func (v Vector3) Rotated(axis Vector3, angle float32) Vector3 {
+	r := BasisFromAxisAndAngle([3]float32{axis.X, axis.Y, axis.Z}, angle).Xform([3]float32{v.X, v.Y, v.Z})
+	return NewVector3(r[0], r[1], r[2])
+}
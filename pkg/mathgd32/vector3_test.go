@@ -0,0 +1,84 @@
+package mathgd32
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// The Vector3 tests run each operation in single and double precision and
+// require the results to agree within CMP_EPSILON.
+
+var v3a, v3b = vector3.New(1.5, -2.25, 0.75), vector3.New(-0.5, 4, 2)
+
+func checkVector3(t *testing.T, name string, got Vector3, want vector3.Vector3) {
+	t.Helper()
+	if !got.IsEqualApprox(FromVector3(want)) {
+		t.Errorf("%s = %v, want %v", name, got, want)
+	}
+}
+
+func TestVector3_Arithmetic(t *testing.T) {
+	a, b := FromVector3(v3a), FromVector3(v3b)
+	checkVector3(t, "Add", a.Add(b), v3a.Add(v3b))
+	checkVector3(t, "Sub", a.Sub(b), v3a.Sub(v3b))
+	checkVector3(t, "Mul", a.Mul(b), v3a.Mul(v3b))
+	checkVector3(t, "Div", a.Div(b), v3a.Div(v3b))
+	checkVector3(t, "Addf", a.Addf(2), v3a.Addf(2))
+	checkVector3(t, "Subf", a.Subf(2), v3a.Subf(2))
+	checkVector3(t, "Mulf", a.Mulf(2), v3a.Mulf(2))
+	checkVector3(t, "Divf", a.Divf(2), v3a.Divf(2))
+	checkVector3(t, "Cross", a.Cross(b), v3a.Cross(v3b))
+	checkVector3(t, "Lerp", a.Lerp(b, 0.3), v3a.Lerp(v3b, 0.3))
+}
+
+func TestVector3_Length(t *testing.T) {
+	a, b := FromVector3(v3a), FromVector3(v3b)
+	checkScalar(t, "Dot", a.Dot(b), v3a.Dot(v3b))
+	checkScalar(t, "Length", a.Length(), v3a.Length())
+	checkScalar(t, "LengthSquared", a.LengthSquared(), v3a.LengthSquared())
+	checkScalar(t, "DistanceTo", a.DistanceTo(b), v3a.DistanceTo(v3b))
+	checkScalar(t, "DistanceSquaredTo", a.DistanceSquaredTo(b), v3a.DistanceSquaredTo(v3b))
+	checkScalar(t, "AngleTo", a.AngleTo(b), v3a.AngleTo(v3b))
+	checkVector3(t, "Normalized", a.Normalized(), v3a.Normalized())
+	checkVector3(t, "DirectionTo", a.DirectionTo(b), v3a.DirectionTo(v3b))
+	if !a.Normalized().IsNormalized() {
+		t.Errorf("Normalized().IsNormalized() = false")
+	}
+	if got := Vector3Zero().Normalized(); got != Vector3Zero() {
+		t.Errorf("Zero().Normalized() = %v, want zero", got)
+	}
+}
+
+func TestVector3_Rounding(t *testing.T) {
+	a := FromVector3(v3a)
+	checkVector3(t, "Abs", a.Abs(), v3a.Abs())
+	checkVector3(t, "Sign", a.Sign(), v3a.Sign())
+	checkVector3(t, "Floor", a.Floor(), v3a.Floor())
+	checkVector3(t, "Ceil", a.Ceil(), v3a.Ceil())
+	checkVector3(t, "Round", a.Round(), v3a.Round())
+	checkVector3(t, "Posmod", a.Posmod(2), v3a.Posmod(2))
+	checkVector3(t, "Posmodv", a.Posmodv(NewVector3(1, 3, 0.5)), v3a.Posmodv(vector3.New(1, 3, 0.5)))
+	checkVector3(t, "Project", a.Project(FromVector3(v3b)), v3a.Project(v3b))
+}
+
+func TestVector3_IsFinite(t *testing.T) {
+	if !NewVector3(1.5, -3e38, 0).IsFinite() {
+		t.Errorf("IsFinite() = false for a finite vector")
+	}
+	inf := float32(math.Inf(1))
+	for _, bad := range []float32{inf, -inf, float32(math.NaN())} {
+		for _, v := range []Vector3{NewVector3(bad, 0, 0), NewVector3(0, bad, 0), NewVector3(0, 0, bad)} {
+			if v.IsFinite() {
+				t.Errorf("%v.IsFinite() = true, want false", v)
+			}
+		}
+	}
+}
+
+func TestVector3_Rotated(t *testing.T) {
+	axis := vector3.New(1, 2, 3).Normalized()
+	got := FromVector3(v3a).Rotated(FromVector3(axis), 0.7)
+	checkVector3(t, "Rotated", got, v3a.Rotated(axis, 0.7))
+}
@@ -0,0 +1,41 @@
+// Package physics2d provides small integrators for simple 2D simulations.
+package physics2d
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/transform2d"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// Body is a point mass integrated with semi-implicit Euler. Position is the
+// source of truth; Step copies it into the origin of Transform, leaving the
+// rotation and scale alone.
+type Body struct {
+	Position  vector2.Vector2
+	Velocity  vector2.Vector2
+	Transform transform2d.Transform2D
+
+	// Gravity is an acceleration applied every step regardless of mass.
+	Gravity vector2.Vector2
+}
+
+// NewBody returns a body at position with an identity transform and no gravity.
+func NewBody(position vector2.Vector2) *Body {
+	return &Body{
+		Position:  position,
+		Transform: transform2d.NewTransform2D(0, position),
+	}
+}
+
+// Step advances the body by dt seconds under force and gravity. The velocity
+// is updated first and the new velocity moves the position, which keeps
+// orbits and springs stable where explicit Euler would gain energy.
+// A mass <= 0 ignores force, as if the body were infinitely heavy.
+func (b *Body) Step(dt float64, force vector2.Vector2, mass float64) {
+	accel := b.Gravity
+	if mass > 0 {
+		accel = accel.Add(force.Divf(mass))
+	}
+	b.Velocity = b.Velocity.Add(accel.Mulf(dt))
+	b.Position = b.Position.Add(b.Velocity.Mulf(dt))
+	b.Transform.Columns[2] = b.Position
+}
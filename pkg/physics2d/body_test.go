@@ -0,0 +1,50 @@
+package physics2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+func TestBody_Step(t *testing.T) {
+	v0 := vector2.New(10, -20)
+	g := vector2.New(0, 9.8)
+	b := NewBody(vector2.Zero())
+	b.Velocity = v0
+	b.Gravity = g
+
+	const dt = 1.0 / 600
+	for i := 1; i <= 1200; i++ {
+		b.Step(dt, vector2.Zero(), 1)
+		if i%300 != 0 {
+			continue
+		}
+		// Compare against the analytic parabola p = v0 t + g t^2 / 2.
+		tm := float64(i) * dt
+		want := v0.Mulf(tm).Add(g.Mulf(tm * tm / 2))
+		if d := b.Position.DistanceTo(want); d > 0.02 {
+			t.Errorf("t=%v: position %v is %v from the parabola %v", tm, b.Position, d, want)
+		}
+		if b.Transform.Columns[2] != b.Position {
+			t.Errorf("t=%v: transform origin %v, want %v", tm, b.Transform.Columns[2], b.Position)
+		}
+	}
+	if got, want := b.Velocity, v0.Add(g.Mulf(2)); math.Abs(got.X-want.X) > 1e-9 || math.Abs(got.Y-want.Y) > 1e-9 {
+		t.Errorf("velocity after 2s = %v, want %v", got, want)
+	}
+}
+
+func TestBody_StepForce(t *testing.T) {
+	b := NewBody(vector2.Zero())
+	b.Step(1, vector2.New(4, 0), 2)
+	if b.Velocity != vector2.New(2, 0) || b.Position != vector2.New(2, 0) {
+		t.Errorf("after one step: velocity %v, position %v, want (2, 0) for both", b.Velocity, b.Position)
+	}
+
+	// Zero mass ignores the force instead of producing Inf.
+	b.Step(1, vector2.New(4, 0), 0)
+	if b.Velocity != vector2.New(2, 0) {
+		t.Errorf("zero mass changed velocity to %v", b.Velocity)
+	}
+}
@@ -0,0 +1,38 @@
+package physics2d
+
+import (
+	"math"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// CircleCircleTOI returns the time of impact of two circles moving with
+// constant velocities over dt, as a fraction of dt in [0, 1]. Circles that
+// already overlap hit at t = 0; circles that only touch at one instant
+// (a graze) count as a hit. Sweeping the whole interval catches contacts a
+// discrete overlap test would step over.
+func CircleCircleTOI(posA, velA vector2.Vector2, rA float64, posB, velB vector2.Vector2, rB float64, dt float64) (t float64, hit bool) {
+	d := posB.Sub(posA)
+	r := rA + rB
+	c := d.Dot(d) - r*r
+	if c <= 0 {
+		return 0, true
+	}
+	// Solve |d + w t|^2 = r^2 for the relative displacement w over dt.
+	w := velB.Sub(velA).Mulf(dt)
+	a := w.Dot(w)
+	b := 2 * d.Dot(w)
+	if a == 0 || b >= 0 {
+		// Not moving relative to each other, or moving apart.
+		return 0, false
+	}
+	disc := b*b - 4*a*c
+	if disc < 0 {
+		return 0, false
+	}
+	t = (-b - math.Sqrt(disc)) / (2 * a)
+	if t > 1 {
+		return 0, false
+	}
+	return t, true
+}
@@ -0,0 +1,46 @@
+package physics2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+func TestPhysics2D_CircleCircleTOI(t *testing.T) {
+	zero := vector2.Zero()
+	tests := []struct {
+		name       string
+		posA, velA vector2.Vector2
+		rA         float64
+		posB, velB vector2.Vector2
+		rB, dt     float64
+		wantT      float64
+		wantHit    bool
+	}{
+		{"head on", vector2.New(-5, 0), vector2.New(8, 0), 1, vector2.New(5, 0), vector2.New(-8, 0), 1, 1, 0.5, true},
+		{"scaled by dt", vector2.New(-5, 0), vector2.New(8, 0), 1, vector2.New(5, 0), vector2.New(-8, 0), 1, 2, 0.25, true},
+		{"tunnelling", vector2.New(-5, 0), vector2.New(100, 0), 0.5, zero, zero, 0.5, 1, 0.04, true},
+		{"overlapping", zero, vector2.New(-1, 0), 1, vector2.New(1.5, 0), vector2.New(1, 0), 1, 1, 0, true},
+		{"moving apart", zero, vector2.New(-1, 0), 1, vector2.New(3, 0), vector2.New(1, 0), 1, 1, 0, false},
+		{"too slow", vector2.New(-5, 0), vector2.New(1, 0), 1, vector2.New(5, 0), zero, 1, 1, 0, false},
+		{"parallel", zero, vector2.New(1, 0), 1, vector2.New(0, 3), vector2.New(1, 0), 1, 1, 0, false},
+		{"grazing", vector2.New(-5, 2), vector2.New(10, 0), 1, zero, zero, 1, 1, 0.5, true},
+		{"near miss", vector2.New(-5, 2.001), vector2.New(10, 0), 1, zero, zero, 1, 1, 0, false},
+	}
+	for _, tt := range tests {
+		gotT, gotHit := CircleCircleTOI(tt.posA, tt.velA, tt.rA, tt.posB, tt.velB, tt.rB, tt.dt)
+		if gotHit != tt.wantHit || math.Abs(gotT-tt.wantT) > 1e-9 {
+			t.Errorf("%s: CircleCircleTOI = (%v, %v), want (%v, %v)", tt.name, gotT, gotHit, tt.wantT, tt.wantHit)
+		}
+		if !gotHit || gotT == 0 {
+			continue
+		}
+		// At the reported time the circles are exactly touching.
+		a := tt.posA.Add(tt.velA.Mulf(gotT * tt.dt))
+		b := tt.posB.Add(tt.velB.Mulf(gotT * tt.dt))
+		if d := a.DistanceTo(b); math.Abs(d-(tt.rA+tt.rB)) > 1e-9 {
+			t.Errorf("%s: distance at t=%v is %v, want %v", tt.name, gotT, d, tt.rA+tt.rB)
+		}
+	}
+}
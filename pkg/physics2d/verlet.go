@@ -0,0 +1,60 @@
+package physics2d
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// VerletPoint is a particle integrated with position Verlet. The velocity is
+// implicit in the difference between Position and Previous, so constraints
+// can move Position directly and the motion follows.
+type VerletPoint struct {
+	Position vector2.Vector2
+	Previous vector2.Vector2
+
+	// Pinned points ignore Integrate and are never moved by constraints.
+	Pinned bool
+}
+
+// NewVerletPoint returns a point at rest at position.
+func NewVerletPoint(position vector2.Vector2) *VerletPoint {
+	return &VerletPoint{Position: position, Previous: position}
+}
+
+// Integrate advances the point by dt seconds under accel. dt should stay
+// constant between calls, since the previous position encodes the velocity
+// of the last step.
+func (p *VerletPoint) Integrate(dt float64, accel vector2.Vector2) {
+	if p.Pinned {
+		return
+	}
+	next := p.Position.Mulf(2).Sub(p.Previous).Add(accel.Mulf(dt * dt))
+	p.Previous = p.Position
+	p.Position = next
+}
+
+// DistanceConstraint moves a and b along the line between them toward a
+// separation of rest. Free points share the correction equally; if one is
+// pinned the other takes all of it. Repeated calls over a set of constraints
+// relax the whole system. Coincident points are left alone since they have
+// no direction to separate along.
+func DistanceConstraint(a, b *VerletPoint, rest float64) {
+	if a.Pinned && b.Pinned {
+		return
+	}
+	delta := b.Position.Sub(a.Position)
+	dist := delta.Length()
+	if dist == 0 {
+		return
+	}
+	correction := delta.Mulf((dist - rest) / dist)
+	switch {
+	case a.Pinned:
+		b.Position = b.Position.Sub(correction)
+	case b.Pinned:
+		a.Position = a.Position.Add(correction)
+	default:
+		half := correction.Mulf(0.5)
+		a.Position = a.Position.Add(half)
+		b.Position = b.Position.Sub(half)
+	}
+}
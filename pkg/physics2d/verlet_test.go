@@ -0,0 +1,72 @@
+package physics2d
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+func TestVerletPoint_Integrate(t *testing.T) {
+	g := vector2.New(0, 9.8)
+	p := NewVerletPoint(vector2.Zero())
+	// Start with a velocity of (3, 0) by offsetting the previous position.
+	const dt = 1.0 / 100
+	p.Previous = vector2.New(-3*dt, 0)
+	for i := 0; i < 100; i++ {
+		p.Integrate(dt, g)
+	}
+	want := vector2.New(3, 9.8/2)
+	if d := p.Position.DistanceTo(want); d > 0.1 {
+		t.Errorf("after 1s position = %v, want about %v", p.Position, want)
+	}
+
+	pinned := NewVerletPoint(vector2.New(1, 1))
+	pinned.Pinned = true
+	pinned.Integrate(dt, g)
+	if pinned.Position != vector2.New(1, 1) {
+		t.Errorf("pinned point moved to %v", pinned.Position)
+	}
+}
+
+func TestVerletPoint_DistanceConstraint(t *testing.T) {
+	a := NewVerletPoint(vector2.New(0, 0))
+	b := NewVerletPoint(vector2.New(5, 3))
+	const rest = 2.0
+	for i := 0; i < 10; i++ {
+		DistanceConstraint(a, b, rest)
+	}
+	if d := a.Position.DistanceTo(b.Position); math.Abs(d-rest) > 1e-9 {
+		t.Errorf("distance after relaxing = %v, want %v", d, rest)
+	}
+	// Equal correction keeps the midpoint fixed.
+	if mid := a.Position.Add(b.Position).Mulf(0.5); mid.DistanceTo(vector2.New(2.5, 1.5)) > 1e-9 {
+		t.Errorf("midpoint moved to %v", mid)
+	}
+
+	// A rope hanging from a pinned point settles at its rest lengths.
+	const n, seg = 5, 1.0
+	rope := make([]*VerletPoint, n)
+	for i := range rope {
+		rope[i] = NewVerletPoint(vector2.New(float64(i)*0.5, 0))
+	}
+	rope[0].Pinned = true
+	for step := 0; step < 2000; step++ {
+		for _, p := range rope {
+			p.Integrate(1.0/60, vector2.New(0, 9.8))
+		}
+		for iter := 0; iter < 20; iter++ {
+			for i := 1; i < n; i++ {
+				DistanceConstraint(rope[i-1], rope[i], seg)
+			}
+		}
+	}
+	if rope[0].Position != vector2.Zero() {
+		t.Errorf("pinned end moved to %v", rope[0].Position)
+	}
+	for i := 1; i < n; i++ {
+		if d := rope[i-1].Position.DistanceTo(rope[i].Position); math.Abs(d-seg) > 1e-3 {
+			t.Errorf("segment %d length = %v, want %v", i, d, seg)
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package plane
+
+/**************************************************************************/
+/*  plane.h                                                               */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// Plane is the plane of points p with Normal.Dot(p) == D. With a unit normal,
+// D is the distance from the origin along the normal and points on the side
+// the normal points to are "over" the plane.
+type Plane struct {
+	Normal vector3.Vector3 `json:"normal"`
+	D      float64         `json:"d"`
+}
+
+// New returns the plane with the given normal and distance from the origin.
+func New(normal vector3.Vector3, d float64) Plane {
+	return Plane{Normal: normal, D: d}
+}
+
+// FromPointNormal returns the plane through point with the given normal.
+func FromPointNormal(point, normal vector3.Vector3) Plane {
+	return New(normal, normal.Dot(point))
+}
+
+// Normalized returns the same plane with a unit normal. A plane with a zero
+// normal returns the zero Plane.
+func (p Plane) Normalized() Plane {
+	l := p.Normal.Length()
+	if l == 0 {
+		return Plane{}
+	}
+	return New(p.Normal.Mulf(1/l), p.D/l)
+}
+
+// DistanceTo returns the signed distance from the plane to point, positive
+// over the plane. It is scaled by the normal's length if that is not 1.
+func (p Plane) DistanceTo(point vector3.Vector3) float64 {
+	return p.Normal.Dot(point) - p.D
+}
+
+// IsPointOver reports whether point is on the side of the plane the normal
+// points to.
+func (p Plane) IsPointOver(point vector3.Vector3) bool {
+	return p.Normal.Dot(point) > p.D
+}
@@ -0,0 +1,38 @@
+package plane
+
+import (
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+func TestPlane_DistanceTo(t *testing.T) {
+	p := FromPointNormal(vector3.New(0, 2, 0), vector3.New(0, 1, 0))
+	for _, tt := range []struct {
+		point vector3.Vector3
+		want  float64
+		over  bool
+	}{
+		{vector3.New(5, 3, -1), 1, true},
+		{vector3.New(0, 2, 7), 0, false},
+		{vector3.New(1, -1, 0), -3, false},
+	} {
+		if got := p.DistanceTo(tt.point); !zerogdscript.IsEqualApprox(got, tt.want) {
+			t.Errorf("DistanceTo(%v) = %v, want %v", tt.point, got, tt.want)
+		}
+		if got := p.IsPointOver(tt.point); got != tt.over {
+			t.Errorf("IsPointOver(%v) = %v, want %v", tt.point, got, tt.over)
+		}
+	}
+}
+
+func TestPlane_Normalized(t *testing.T) {
+	p := New(vector3.New(0, 0, 4), 8).Normalized()
+	if !p.Normal.IsEqualApprox(vector3.New(0, 0, 1)) || !zerogdscript.IsEqualApprox(p.D, 2) {
+		t.Errorf("Normalized() = %v, want normal (0, 0, 1) and D 2", p)
+	}
+	if got := New(vector3.Zero(), 3).Normalized(); got != (Plane{}) {
+		t.Errorf("Normalized() of a zero normal = %v, want the zero Plane", got)
+	}
+}
@@ -0,0 +1,261 @@
+package projection
+
+/**************************************************************************/
+/*  projection.h                                                          */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"math"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/aabb"
+	"github.com/Anaxarchus/zero-gdscript/pkg/plane"
+	"github.com/Anaxarchus/zero-gdscript/pkg/rect2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// Projection is a 4x4 matrix for 3D projective transformations, stored as
+// four columns like Godot: Columns[i][j] is row j of column i.
+type Projection struct {
+	Columns [4][4]float64
+}
+
+// New returns the identity projection.
+func New() Projection {
+	return Projection{Columns: [4][4]float64{
+		{1, 0, 0, 0},
+		{0, 1, 0, 0},
+		{0, 0, 1, 0},
+		{0, 0, 0, 1},
+	}}
+}
+
+// CreatePerspective returns a perspective projection with the given vertical
+// field of view in degrees, aspect ratio (width / height) and clip planes.
+// Degenerate arguments (zero aspect, zero fov or zNear == zFar) return the
+// identity, as in Godot.
+func CreatePerspective(fovyDegrees, aspect, zNear, zFar float64) Projection {
+	p := New()
+	radians := fovyDegrees / 2 * math.Pi / 180
+	deltaZ := zFar - zNear
+	sine := math.Sin(radians)
+	if deltaZ == 0 || sine == 0 || aspect == 0 {
+		return p
+	}
+	cotangent := math.Cos(radians) / sine
+
+	p.Columns[0][0] = cotangent / aspect
+	p.Columns[1][1] = cotangent
+	p.Columns[2][2] = -(zFar + zNear) / deltaZ
+	p.Columns[2][3] = -1
+	p.Columns[3][2] = -2 * zNear * zFar / deltaZ
+	p.Columns[3][3] = 0
+	return p
+}
+
+// Xform transforms v as a point (w = 1) and divides by the resulting w.
+func (p Projection) Xform(v vector3.Vector3) vector3.Vector3 {
+	c := p.Columns
+	x := c[0][0]*v.X + c[1][0]*v.Y + c[2][0]*v.Z + c[3][0]
+	y := c[0][1]*v.X + c[1][1]*v.Y + c[2][1]*v.Z + c[3][1]
+	z := c[0][2]*v.X + c[1][2]*v.Y + c[2][2]*v.Z + c[3][2]
+	w := c[0][3]*v.X + c[1][3]*v.Y + c[2][3]*v.Z + c[3][3]
+	return vector3.New(x/w, y/w, z/w)
+}
+
+// Determinant returns the determinant of the matrix.
+func (p Projection) Determinant() float64 {
+	_, det := p.adjugate()
+	return det
+}
+
+// Inverse returns the inverse matrix. A singular projection returns the zero
+// Projection.
+func (p Projection) Inverse() Projection {
+	adj, det := p.adjugate()
+	if det == 0 {
+		return Projection{}
+	}
+	for i := range adj.Columns {
+		for j := range adj.Columns[i] {
+			adj.Columns[i][j] /= det
+		}
+	}
+	return adj
+}
+
+// adjugate returns the adjugate matrix and the determinant, computed from the
+// 2x2 minors of the top and bottom row pairs.
+func (p Projection) adjugate() (Projection, float64) {
+	// m[r][c] with rows and columns in the usual order.
+	var m [4][4]float64
+	for c := 0; c < 4; c++ {
+		for r := 0; r < 4; r++ {
+			m[r][c] = p.Columns[c][r]
+		}
+	}
+
+	s0 := m[0][0]*m[1][1] - m[1][0]*m[0][1]
+	s1 := m[0][0]*m[1][2] - m[1][0]*m[0][2]
+	s2 := m[0][0]*m[1][3] - m[1][0]*m[0][3]
+	s3 := m[0][1]*m[1][2] - m[1][1]*m[0][2]
+	s4 := m[0][1]*m[1][3] - m[1][1]*m[0][3]
+	s5 := m[0][2]*m[1][3] - m[1][2]*m[0][3]
+
+	c5 := m[2][2]*m[3][3] - m[3][2]*m[2][3]
+	c4 := m[2][1]*m[3][3] - m[3][1]*m[2][3]
+	c3 := m[2][1]*m[3][2] - m[3][1]*m[2][2]
+	c2 := m[2][0]*m[3][3] - m[3][0]*m[2][3]
+	c1 := m[2][0]*m[3][2] - m[3][0]*m[2][2]
+	c0 := m[2][0]*m[3][1] - m[3][0]*m[2][1]
+
+	det := s0*c5 - s1*c4 + s2*c3 + s3*c2 - s4*c1 + s5*c0
+
+	inv := [4][4]float64{
+		{
+			m[1][1]*c5 - m[1][2]*c4 + m[1][3]*c3,
+			-m[0][1]*c5 + m[0][2]*c4 - m[0][3]*c3,
+			m[3][1]*s5 - m[3][2]*s4 + m[3][3]*s3,
+			-m[2][1]*s5 + m[2][2]*s4 - m[2][3]*s3,
+		},
+		{
+			-m[1][0]*c5 + m[1][2]*c2 - m[1][3]*c1,
+			m[0][0]*c5 - m[0][2]*c2 + m[0][3]*c1,
+			-m[3][0]*s5 + m[3][2]*s2 - m[3][3]*s1,
+			m[2][0]*s5 - m[2][2]*s2 + m[2][3]*s1,
+		},
+		{
+			m[1][0]*c4 - m[1][1]*c2 + m[1][3]*c0,
+			-m[0][0]*c4 + m[0][1]*c2 - m[0][3]*c0,
+			m[3][0]*s4 - m[3][1]*s2 + m[3][3]*s0,
+			-m[2][0]*s4 + m[2][1]*s2 - m[2][3]*s0,
+		},
+		{
+			-m[1][0]*c3 + m[1][1]*c1 - m[1][2]*c0,
+			m[0][0]*c3 - m[0][1]*c1 + m[0][2]*c0,
+			-m[3][0]*s3 + m[3][1]*s1 - m[3][2]*s0,
+			m[2][0]*s3 - m[2][1]*s1 + m[2][2]*s0,
+		},
+	}
+
+	var res Projection
+	for r := 0; r < 4; r++ {
+		for c := 0; c < 4; c++ {
+			res.Columns[c][r] = inv[r][c]
+		}
+	}
+	return res, det
+}
+
+// The indices of the planes in a Frustum, in Godot's order.
+const (
+	PlaneNear = iota
+	PlaneFar
+	PlaneLeft
+	PlaneTop
+	PlaneRight
+	PlaneBottom
+)
+
+// Frustum is the view volume of a projection, bounded by six planes with unit
+// normals pointing outwards, indexed by PlaneNear through PlaneBottom.
+type Frustum [6]plane.Plane
+
+// GetProjectionPlanes returns the planes bounding the view volume in view
+// space (camera at the origin looking down -Z), extracted from the matrix
+// like Godot's Projection.get_projection_planes with an identity transform.
+func (p Projection) GetProjectionPlanes() Frustum {
+	c := p.Columns
+	row := func(r int) [4]float64 {
+		return [4]float64{c[0][r], c[1][r], c[2][r], c[3][r]}
+	}
+	// A point is inside where (row3 + sign*row) . (x, y, z, 1) >= 0.
+	fromRows := func(sign float64, r int) plane.Plane {
+		w, q := row(3), row(r)
+		n := vector3.New(w[0]+sign*q[0], w[1]+sign*q[1], w[2]+sign*q[2])
+		return plane.New(n.Mulf(-1), w[3]+sign*q[3]).Normalized()
+	}
+	var f Frustum
+	f[PlaneNear] = fromRows(1, 2)
+	f[PlaneFar] = fromRows(-1, 2)
+	f[PlaneLeft] = fromRows(1, 0)
+	f[PlaneTop] = fromRows(-1, 1)
+	f[PlaneRight] = fromRows(-1, 0)
+	f[PlaneBottom] = fromRows(1, 1)
+	return f
+}
+
+// HasPoint reports whether point, in the frustum's space, is inside it or on
+// its boundary.
+func (f Frustum) HasPoint(point vector3.Vector3) bool {
+	for _, p := range f {
+		if p.IsPointOver(point) {
+			return false
+		}
+	}
+	return true
+}
+
+// IntersectsAABB reports whether box, in the frustum's space, may be visible.
+// It is conservative like aabb.AABB.IntersectsConvexShape: boxes just outside
+// a corner of the frustum can be reported as visible.
+func (f Frustum) IntersectsAABB(box aabb.AABB) bool {
+	return box.IntersectsConvexShape(f[:])
+}
+
+// WorldToScreen projects a point in view space (camera at the origin looking
+// down -Z; apply the inverse camera transform to world points first) to pixel
+// coordinates in viewport, with Y pointing down as in Godot.
+func (p Projection) WorldToScreen(worldPoint vector3.Vector3, viewport rect2.Rect2) vector2.Vector2 {
+	ndc := p.Xform(worldPoint)
+	return vector2.New(
+		viewport.Position.X+(ndc.X*0.5+0.5)*viewport.Size.X,
+		viewport.Position.Y+(0.5-ndc.Y*0.5)*viewport.Size.Y,
+	)
+}
+
+// ScreenToWorldRay returns the view-space ray through a pixel of viewport,
+// the inverse of WorldToScreen. The origin lies on the near plane and dir is
+// normalized. A singular projection returns a zero ray.
+func (p Projection) ScreenToWorldRay(screenPoint vector2.Vector2, viewport rect2.Rect2) (origin, dir vector3.Vector3) {
+	x := (screenPoint.X-viewport.Position.X)/viewport.Size.X*2 - 1
+	y := 1 - (screenPoint.Y-viewport.Position.Y)/viewport.Size.Y*2
+
+	inv := p.Inverse()
+	if inv == (Projection{}) {
+		return vector3.Zero(), vector3.Zero()
+	}
+	near := inv.Xform(vector3.New(x, y, -1))
+	far := inv.Xform(vector3.New(x, y, 1))
+	return near, far.Sub(near).Normalized()
+}
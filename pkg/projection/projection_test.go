@@ -0,0 +1,124 @@
+package projection
+
+import (
+	"math"
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/aabb"
+	"github.com/Anaxarchus/zero-gdscript/pkg/plane"
+	"github.com/Anaxarchus/zero-gdscript/pkg/rect2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+func TestProjection_Inverse(t *testing.T) {
+	p := CreatePerspective(70, 16.0/9.0, 0.05, 4000)
+	id := New()
+	prod := p.Inverse()
+	// Multiply p * p^-1 column by column.
+	for c := 0; c < 4; c++ {
+		for r := 0; r < 4; r++ {
+			sum := 0.0
+			for k := 0; k < 4; k++ {
+				sum += p.Columns[k][r] * prod.Columns[c][k]
+			}
+			if math.Abs(sum-id.Columns[c][r]) > 1e-9 {
+				t.Errorf("(P * P^-1)[%d][%d] = %v, want %v", c, r, sum, id.Columns[c][r])
+			}
+		}
+	}
+
+	if got := (Projection{}).Inverse(); got != (Projection{}) {
+		t.Errorf("singular Inverse() = %v, want zero", got)
+	}
+}
+
+func TestProjection_WorldToScreen(t *testing.T) {
+	p := CreatePerspective(60, 2, 0.1, 100)
+	viewport := rect2.New(0, 0, 800, 400)
+
+	if got := p.WorldToScreen(vector3.New(0, 0, -10), viewport); !got.IsEqualApprox(vector2.New(400, 200)) {
+		t.Errorf("WorldToScreen(straight ahead) = %v, want the viewport center", got)
+	}
+	// Up and right in view space is up and right on screen, with Y down.
+	if got := p.WorldToScreen(vector3.New(1, 1, -10), viewport); got.X <= 400 || got.Y >= 200 {
+		t.Errorf("WorldToScreen(up right) = %v, want right of and above the center", got)
+	}
+}
+
+func TestProjection_ScreenToWorldRay(t *testing.T) {
+	p := CreatePerspective(60, 2, 0.1, 100)
+	viewport := rect2.New(0, 0, 800, 400)
+
+	origin, dir := p.ScreenToWorldRay(viewport.GetCenter(), viewport)
+	if !dir.IsEqualApprox(vector3.New(0, 0, -1)) {
+		t.Errorf("center ray dir = %v, want (0, 0, -1)", dir)
+	}
+	if !origin.IsEqualApprox(vector3.New(0, 0, -0.1)) {
+		t.Errorf("center ray origin = %v, want the near plane center", origin)
+	}
+
+	// A ray through any pixel projects back onto that pixel.
+	pixel := vector2.New(123, 321)
+	origin, dir = p.ScreenToWorldRay(pixel, viewport)
+	if got := p.WorldToScreen(origin.Add(dir.Mulf(50)), viewport); !got.IsEqualApprox(pixel) {
+		t.Errorf("WorldToScreen(point on ray) = %v, want %v", got, pixel)
+	}
+}
+
+func TestProjection_GetProjectionPlanes(t *testing.T) {
+	// A 90 degree square frustum: the side planes are at 45 degrees.
+	f := CreatePerspective(90, 1, 1, 100).GetProjectionPlanes()
+	s := math.Sqrt2 / 2
+	want := Frustum{
+		PlaneNear:   plane.New(vector3.New(0, 0, 1), -1),
+		PlaneFar:    plane.New(vector3.New(0, 0, -1), 100),
+		PlaneLeft:   plane.New(vector3.New(-s, 0, s), 0),
+		PlaneTop:    plane.New(vector3.New(0, s, s), 0),
+		PlaneRight:  plane.New(vector3.New(s, 0, s), 0),
+		PlaneBottom: plane.New(vector3.New(0, -s, s), 0),
+	}
+	for i, p := range f {
+		if !p.Normal.IsEqualApprox(want[i].Normal) || math.Abs(p.D-want[i].D) > 1e-9 {
+			t.Errorf("plane %d = %v, want %v", i, p, want[i])
+		}
+	}
+
+	for _, tt := range []struct {
+		point vector3.Vector3
+		want  bool
+	}{
+		{vector3.New(0, 0, -10), true},
+		{vector3.New(9, -9, -10), true},
+		{vector3.New(11, 0, -10), false},
+		{vector3.New(0, 11, -10), false},
+		{vector3.New(0, 0, -0.5), false},
+		{vector3.New(0, 0, -101), false},
+		{vector3.New(0, 0, 10), false},
+	} {
+		if got := f.HasPoint(tt.point); got != tt.want {
+			t.Errorf("HasPoint(%v) = %v, want %v", tt.point, got, tt.want)
+		}
+	}
+}
+
+func TestFrustum_IntersectsAABB(t *testing.T) {
+	f := CreatePerspective(90, 1, 1, 100).GetProjectionPlanes()
+	unit := vector3.New(1, 1, 1)
+	for _, tt := range []struct {
+		name string
+		box  aabb.AABB
+		want bool
+	}{
+		{"inside", aabb.New(vector3.New(-0.5, -0.5, -10), unit), true},
+		{"behind", aabb.New(vector3.New(-0.5, -0.5, 5), unit), false},
+		{"beyond far", aabb.New(vector3.New(-0.5, -0.5, -200), unit), false},
+		{"left", aabb.New(vector3.New(-20, -0.5, -10), unit), false},
+		{"straddling right", aabb.New(vector3.New(9.5, -0.5, -10), unit), true},
+		{"straddling near", aabb.New(vector3.New(-0.5, -0.5, -1.5), unit), true},
+	} {
+		if got := f.IntersectsAABB(tt.box); got != tt.want {
+			t.Errorf("%s: IntersectsAABB = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+}
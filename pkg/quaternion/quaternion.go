@@ -4,6 +4,7 @@ import (
 	"math"
 
 	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
 	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
 )
 
@@ -77,18 +78,71 @@ func IDENTITY() Quaternion {
 	return New(0, 0, 0, 1)
 }
 
-// TODO: Port Basis class from Godot
-// Constructs a quaternion from the given Basis.
-//func Basis() Quaternion {
-//	return New(0, 0, 0, 0)
-//}
+// FromBasis constructs a quaternion from the given rotation Basis.
+// The basis must be a pure rotation; orthonormalize it first if it is scaled.
+func FromBasis(b basis.Basis) Quaternion {
+	return FromArray(b.GetQuaternion())
+}
+
+// ToBasis returns the rotation Basis of the quaternion.
+func (q Quaternion) ToBasis() basis.Basis {
+	return basis.FromQuaternion(q.AsArray())
+}
 
-// Constructs a quaternion that will rotate around the given axis by the specified angle. The axis must be a normalized vector.
+// Constructs a quaternion that will rotate around the given axis by the specified angle.
+// A non-normalized axis is normalized first; a zero axis returns IDENTITY.
 func Rotated(axisNormal vector3.Vector3, angle float64) Quaternion {
+	q, _ := RotatedChecked(axisNormal, angle)
+	return q
+}
+
+// RotatedChecked is like Rotated but returns ErrZeroLength for a zero axis.
+func RotatedChecked(axisNormal vector3.Vector3, angle float64) (Quaternion, error) {
 	if !axisNormal.IsNormalized() {
-		return IDENTITY()
+		if axisNormal.LengthSquared() == 0 {
+			return IDENTITY(), zerogdscript.ErrZeroLength
+		}
+		axisNormal = axisNormal.Normalized()
 	}
-	return New(axisNormal.X, axisNormal.Y, axisNormal.Z, angle)
+	s := math.Sin(angle * 0.5)
+	return New(axisNormal.X*s, axisNormal.Y*s, axisNormal.Z*s, math.Cos(angle*0.5)), nil
+}
+
+// FromRotationVector returns the rotation around rv normalized by the angle
+// |rv| in radians, the exponential map from the compact three-parameter
+// rotation vector. The zero vector gives IDENTITY, and small vectors stay
+// accurate instead of dividing by a vanishing length.
+func FromRotationVector(rv vector3.Vector3) Quaternion {
+	angle := rv.Length()
+	half := angle * 0.5
+	var s float64
+	if angle < 1e-4 {
+		// sin(half)/angle by its Taylor series, exact to float64 precision here.
+		s = 0.5 - angle*angle/48
+	} else {
+		s = math.Sin(half) / angle
+	}
+	return New(rv.X*s, rv.Y*s, rv.Z*s, math.Cos(half))
+}
+
+// ToRotationVector returns the rotation as axis times angle, the inverse of
+// FromRotationVector. The quaternion must be normalized. q and -q describe
+// the same rotation and give the same vector, with the angle in [0, PI];
+// at exactly PI either of the two opposite vectors may be returned.
+func (q Quaternion) ToRotationVector() vector3.Vector3 {
+	v := vector3.New(q.X, q.Y, q.Z)
+	w := q.W
+	if w < 0 {
+		v, w = v.Mulf(-1), -w
+	}
+	n := v.Length()
+	if n < 1e-8 {
+		// angle/n approaches 2/w as the angle goes to zero.
+		return v.Mulf(2 / w)
+	}
+	// atan2 stays accurate near both 0 and PI, where acos(w) and asin(n) lose
+	// precision.
+	return v.Mulf(2 * math.Atan2(n, w) / n)
 }
 
 // Constructs a Quaternion as a copy of the given Quaternion.
@@ -97,15 +151,203 @@ func From(quaternion *Quaternion) Quaternion {
 }
 
 // Constructs a quaternion representing the shortest arc between two points on the surface of a sphere with a radius of 1.0.
+// Non-normalized vectors are normalized first; a zero vector returns IDENTITY.
 func Between(p_v0, p_v1 vector3.Vector3) Quaternion { // Shortest arc.
+	q, _ := BetweenChecked(p_v0, p_v1)
+	return q
+}
+
+// BetweenChecked is like Between but returns ErrZeroLength if either vector is zero.
+func BetweenChecked(p_v0, p_v1 vector3.Vector3) (Quaternion, error) {
+	if p_v0.LengthSquared() == 0 || p_v1.LengthSquared() == 0 {
+		return IDENTITY(), zerogdscript.ErrZeroLength
+	}
+	p_v0 = p_v0.Normalized()
+	p_v1 = p_v1.Normalized()
+
 	c := p_v0.Cross(p_v1)
 	d := p_v0.Dot(p_v1)
 
 	if d < -1.0+zerogdscript.CMP_EPSILON {
-		return New(0, 1, 0, 0)
+		return New(0, 1, 0, 0), nil
 	} else {
 		s := math.Sqrt((1.0 + d) * 2.0)
 		rs := 1.0 / s
-		return New(c.X*rs, c.Y*rs, c.Z*rs, s*0.5)
+		return New(c.X*rs, c.Y*rs, c.Z*rs, s*0.5), nil
 	}
 }
+
+// Mul returns the Hamilton product q * with. The result rotates by with
+// first and then by q.
+func (q Quaternion) Mul(with Quaternion) Quaternion {
+	return New(
+		q.W*with.X+q.X*with.W+q.Y*with.Z-q.Z*with.Y,
+		q.W*with.Y+q.Y*with.W+q.Z*with.X-q.X*with.Z,
+		q.W*with.Z+q.Z*with.W+q.X*with.Y-q.Y*with.X,
+		q.W*with.W-q.X*with.X-q.Y*with.Y-q.Z*with.Z,
+	)
+}
+
+// Dot returns the 4D dot product of two quaternions.
+func (q Quaternion) Dot(with Quaternion) float64 {
+	return q.X*with.X + q.Y*with.Y + q.Z*with.Z + q.W*with.W
+}
+
+// GetAngle returns the rotation angle of the quaternion in radians, in [0, 2*PI].
+// The quaternion must be normalized; W is clamped to [-1, 1] so rounding
+// error never produces NaN.
+func (q Quaternion) GetAngle() float64 {
+	return 2 * math.Acos(zerogdscript.Clampf(q.W, -1, 1))
+}
+
+// GetAxis returns the normalized rotation axis of the quaternion. When the
+// angle is near zero the axis is poorly defined: the vector part is
+// normalized if it is nonzero, and the identity returns (0, 0, 1).
+func (q Quaternion) GetAxis() vector3.Vector3 {
+	if math.Abs(q.W) > 1-zerogdscript.CMP_EPSILON {
+		axis := vector3.New(q.X, q.Y, q.Z)
+		if axis.LengthSquared() == 0 {
+			return vector3.New(0, 0, 1)
+		}
+		return axis.Normalized()
+	}
+	r := 1 / math.Sqrt(1-q.W*q.W)
+	return vector3.New(q.X*r, q.Y*r, q.Z*r)
+}
+
+// Length returns the length of the quaternion as a 4D vector.
+func (q Quaternion) Length() float64 {
+	return math.Sqrt(q.LengthSquared())
+}
+
+// LengthSquared returns the squared length of the quaternion as a 4D vector.
+func (q Quaternion) LengthSquared() float64 {
+	return q.Dot(q)
+}
+
+// Normalize scales the quaternion to unit length in place. A zero quaternion
+// stays zero.
+func (q *Quaternion) Normalize() {
+	lengthsq := q.LengthSquared()
+	if lengthsq == 0 {
+		*q = ZERO()
+		return
+	}
+	length := math.Sqrt(lengthsq)
+	q.X /= length
+	q.Y /= length
+	q.Z /= length
+	q.W /= length
+}
+
+// Normalized returns a copy of the quaternion scaled to unit length. A zero
+// quaternion stays zero.
+func (q Quaternion) Normalized() Quaternion {
+	q.Normalize()
+	return q
+}
+
+// IsNormalized reports whether the quaternion has unit length.
+func (q Quaternion) IsNormalized() bool {
+	// use LengthSquared() instead of Length() to avoid sqrt(), makes it more stringent.
+	return zerogdscript.IsEqualApprox(q.LengthSquared(), 1.0)
+}
+
+// Slerp returns the spherical linear interpolation between q and to by
+// weight. It takes the shorter arc by flipping to when the quaternions lie in
+// opposite hemispheres, and falls back to linear interpolation when they are
+// nearly equal. Both quaternions must be normalized.
+func (q Quaternion) Slerp(to Quaternion, weight float64) Quaternion {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Quaternion.Slerp", q, to)
+	}
+	// calc cosine
+	cosom := q.Dot(to)
+
+	// adjust signs (if necessary)
+	if cosom < 0.0 {
+		cosom = -cosom
+		to = New(-to.X, -to.Y, -to.Z, -to.W)
+	}
+
+	// calculate coefficients
+	var scale0, scale1 float64
+	if 1.0-cosom > zerogdscript.CMP_EPSILON {
+		// standard case (slerp)
+		omega := math.Acos(cosom)
+		sinom := math.Sin(omega)
+		scale0 = math.Sin((1.0-weight)*omega) / sinom
+		scale1 = math.Sin(weight*omega) / sinom
+	} else {
+		// "from" and "to" quaternions are very close
+		//  ... so we can do a linear interpolation
+		scale0 = 1.0 - weight
+		scale1 = weight
+	}
+	// calculate final values
+	return New(
+		scale0*q.X+scale1*to.X,
+		scale0*q.Y+scale1*to.Y,
+		scale0*q.Z+scale1*to.Z,
+		scale0*q.W+scale1*to.W,
+	)
+}
+
+// Slerpni is like Slerp but does not check the arc length, so it may take the
+// longer way around. It returns q unchanged when the quaternions are nearly
+// parallel. Both quaternions must be normalized.
+func (q Quaternion) Slerpni(to Quaternion, weight float64) Quaternion {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Quaternion.Slerpni", q, to)
+	}
+	dot := q.Dot(to)
+	if math.Abs(dot) > 0.9999 {
+		return q
+	}
+	theta := math.Acos(dot)
+	sinT := 1.0 / math.Sin(theta)
+	newFactor := math.Sin(weight*theta) * sinT
+	invFactor := math.Sin((1.0-weight)*theta) * sinT
+	return New(
+		invFactor*q.X+newFactor*to.X,
+		invFactor*q.Y+newFactor*to.Y,
+		invFactor*q.Z+newFactor*to.Z,
+		invFactor*q.W+newFactor*to.W,
+	)
+}
+
+// Xform returns v rotated by the quaternion, which must be normalized.
+func (q Quaternion) Xform(v vector3.Vector3) vector3.Vector3 {
+	u := vector3.New(q.X, q.Y, q.Z)
+	uv := u.Cross(v)
+	return v.Add(uv.Mulf(q.W).Add(u.Cross(uv)).Mulf(2))
+}
+
+// Validate returns a *zerogdscript.ValidationError naming the first component
+// that is NaN or infinite, or wrapping ErrNotNormalized if the quaternion is
+// not unit length within tolerance.
+func (q Quaternion) Validate() error {
+	for _, c := range []struct {
+		field string
+		value float64
+	}{{"X", q.X}, {"Y", q.Y}, {"Z", q.Z}, {"W", q.W}} {
+		if err := zerogdscript.ValidateFinite("Quaternion", c.field, c.value); err != nil {
+			return err
+		}
+	}
+	if !q.IsNormalized() {
+		return &zerogdscript.ValidationError{Type: "Quaternion", Err: zerogdscript.ErrNotNormalized}
+	}
+	return nil
+}
+
+// AsArray returns the components as [X, Y, Z, W], the order Godot and most
+// GPU APIs use.
+func (q Quaternion) AsArray() [4]float64 {
+	return [4]float64{q.X, q.Y, q.Z, q.W}
+}
+
+// FromArray returns the quaternion with components [X, Y, Z, W].
+func FromArray(a [4]float64) Quaternion {
+	return Quaternion{X: a[0], Y: a[1], Z: a[2], W: a[3]}
+}
@@ -1,9 +1,380 @@
 package quaternion
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"testing"
 
-func TestQuaternion_Rotated(t *testing.T) {}
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
+	"github.com/Anaxarchus/zero-gdscript/pkg/rng"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+func TestQuaternion_Rotated(t *testing.T) {
+	v := vector3.New(0.3, -1.2, 2.5)
+	for _, tt := range []struct {
+		axis  vector3.Vector3
+		angle float64
+	}{
+		{vector3.New(0, 1, 0), math.Pi / 2},
+		{vector3.New(1, 0, 0), -math.Pi / 3},
+		{vector3.New(1, 2, 3).Normalized(), 0.7},
+		{vector3.New(-2, 0.5, 1).Normalized(), math.Pi},
+		{vector3.New(0, 0, 1), 0},
+		{vector3.New(0, 0, 1), 3 * math.Pi},
+	} {
+		q := Rotated(tt.axis, tt.angle)
+		if err := q.Validate(); err != nil {
+			t.Errorf("Rotated(%v, %v) = %v is not a unit quaternion: %v", tt.axis, tt.angle, q, err)
+		}
+		got := q.Xform(v)
+		want := vector3.FromArray(basis.FromAxisAndAngle(tt.axis.AsArray(), tt.angle).Xform(v.AsArray()))
+		if !got.IsEqualApprox(want) {
+			t.Errorf("Rotated(%v, %v).Xform(%v) = %v, want %v", tt.axis, tt.angle, v, got, want)
+		}
+	}
+
+	// Half-angle form: a quarter turn about Y.
+	q := Rotated(vector3.New(0, 1, 0), math.Pi/2)
+	h := math.Sqrt2 / 2
+	if !zerogdscript.IsEqualApprox(q.Y, h) || !zerogdscript.IsEqualApprox(q.W, h) || q.X != 0 || q.Z != 0 {
+		t.Errorf("Rotated(up, pi/2) = %v, want (0, %v, 0, %v)", q, h, h)
+	}
+}
+
+func TestQuaternion_Mul(t *testing.T) {
+	qa := Rotated(vector3.New(0, 1, 0), 0.8)
+	qb := Rotated(vector3.New(1, 1, 0).Normalized(), -1.3)
+	qc := Rotated(vector3.New(0.2, -1, 3).Normalized(), 2.1)
+	v := vector3.New(1, -2, 0.5)
+	isEqualApprox := func(a, b Quaternion) bool {
+		return zerogdscript.IsEqualApprox(a.X, b.X) && zerogdscript.IsEqualApprox(a.Y, b.Y) &&
+			zerogdscript.IsEqualApprox(a.Z, b.Z) && zerogdscript.IsEqualApprox(a.W, b.W)
+	}
+
+	for _, q := range []Quaternion{qa, qb, qc} {
+		if got := q.Mul(IDENTITY()); got != q {
+			t.Errorf("%v * IDENTITY = %v", q, got)
+		}
+		if got := IDENTITY().Mul(q); got != q {
+			t.Errorf("IDENTITY * %v = %v", q, got)
+		}
+	}
+
+	// (qa * qb) applies qb first, then qa.
+	got := qa.Mul(qb).Xform(v)
+	want := qa.Xform(qb.Xform(v))
+	if !got.IsEqualApprox(want) {
+		t.Errorf("(qa*qb).Xform(v) = %v, want qa.Xform(qb.Xform(v)) = %v", got, want)
+	}
+	if err := qa.Mul(qb).Validate(); err != nil {
+		t.Errorf("qa*qb is not a unit quaternion: %v", err)
+	}
+
+	// Rotations about the same axis add their angles.
+	up := vector3.New(0, 1, 0)
+	if got, want := Rotated(up, 0.5).Mul(Rotated(up, 0.25)), Rotated(up, 0.75); !isEqualApprox(got, want) {
+		t.Errorf("Rotated(up, 0.5) * Rotated(up, 0.25) = %v, want %v", got, want)
+	}
+
+	// The product is associative but not commutative.
+	if !isEqualApprox(qa.Mul(qb).Mul(qc), qa.Mul(qb.Mul(qc))) {
+		t.Errorf("(qa*qb)*qc != qa*(qb*qc)")
+	}
+	if isEqualApprox(qa.Mul(qb), qb.Mul(qa)) {
+		t.Errorf("qa*qb == qb*qa for non-parallel axes")
+	}
+}
+
+func TestQuaternion_Slerp(t *testing.T) {
+	isEqualApprox := func(a, b Quaternion) bool {
+		return zerogdscript.IsEqualApprox(a.X, b.X) && zerogdscript.IsEqualApprox(a.Y, b.Y) &&
+			zerogdscript.IsEqualApprox(a.Z, b.Z) && zerogdscript.IsEqualApprox(a.W, b.W)
+	}
+	up := vector3.New(0, 1, 0)
+	from := Rotated(up, math.Pi/2)
+	to := Rotated(up, math.Pi)
+
+	for _, slerp := range []struct {
+		name string
+		f    func(Quaternion, Quaternion, float64) Quaternion
+	}{{"Slerp", Quaternion.Slerp}, {"Slerpni", Quaternion.Slerpni}} {
+		if got := slerp.f(from, to, 0); !isEqualApprox(got, from) {
+			t.Errorf("%s weight 0 = %v, want %v", slerp.name, got, from)
+		}
+		if got := slerp.f(from, to, 1); !isEqualApprox(got, to) {
+			t.Errorf("%s weight 1 = %v, want %v", slerp.name, got, to)
+		}
+		// Halfway between 90 and 180 degrees about the same axis is 135 degrees.
+		if got, want := slerp.f(from, to, 0.5), Rotated(up, 3*math.Pi/4); !isEqualApprox(got, want) {
+			t.Errorf("%s weight 0.5 = %v, want %v", slerp.name, got, want)
+		}
+	}
+
+	// The request's case: halfway from identity to a quarter turn is 45 degrees.
+	if got, want := IDENTITY().Slerp(from, 0.5), Rotated(up, math.Pi/4); !isEqualApprox(got, want) {
+		t.Errorf("Slerp(IDENTITY, 90deg, 0.5) = %v, want %v", got, want)
+	}
+
+	a := Rotated(vector3.New(1, 2, 3).Normalized(), 0.4)
+	b := Rotated(vector3.New(-1, 0, 2).Normalized(), 2.5)
+	for w := 0.0; w <= 1; w += 0.125 {
+		if err := a.Slerp(b, w).Validate(); err != nil {
+			t.Errorf("Slerp weight %v is not normalized: %v", w, err)
+		}
+		if err := a.Slerpni(b, w).Validate(); err != nil {
+			t.Errorf("Slerpni weight %v is not normalized: %v", w, err)
+		}
+	}
+
+	// -b is the same rotation as b. Slerp takes the short arc either way,
+	// Slerpni does not.
+	nb := New(-b.X, -b.Y, -b.Z, -b.W)
+	v := vector3.New(1, 0, 0)
+	if got, want := a.Slerp(nb, 0.3).Xform(v), a.Slerp(b, 0.3).Xform(v); !got.IsEqualApprox(want) {
+		t.Errorf("Slerp to -b rotates v to %v, want %v", got, want)
+	}
+	if got, want := a.Slerpni(nb, 0.3).Xform(v), a.Slerpni(b, 0.3).Xform(v); got.IsEqualApprox(want) {
+		t.Errorf("Slerpni to -b took the short arc too")
+	}
+
+	// Nearly equal quaternions fall back to lerp and Slerpni returns q.
+	near := Rotated(up, 1e-4)
+	if got := IDENTITY().Slerp(near, 0.5); !isEqualApprox(got, Rotated(up, 5e-5)) {
+		t.Errorf("Slerp of nearly equal quaternions = %v", got)
+	}
+	if got := IDENTITY().Slerpni(near, 0.5); got != IDENTITY() {
+		t.Errorf("Slerpni of nearly equal quaternions = %v, want IDENTITY", got)
+	}
+}
+
+func TestQuaternion_Normalized(t *testing.T) {
+	q := Rotated(vector3.New(1, -2, 0.5).Normalized(), 1.1)
+	if !q.IsNormalized() {
+		t.Errorf("Rotated(...) = %v has length %v, want 1", q, q.Length())
+	}
+
+	scaled := New(q.X*7, q.Y*7, q.Z*7, q.W*7)
+	if scaled.IsNormalized() {
+		t.Errorf("%v reports normalized", scaled)
+	}
+	if !zerogdscript.IsEqualApprox(scaled.Length(), 7) || !zerogdscript.IsEqualApprox(scaled.LengthSquared(), 49) {
+		t.Errorf("Length = %v, LengthSquared = %v, want 7 and 49", scaled.Length(), scaled.LengthSquared())
+	}
+	n := scaled.Normalized()
+	if !n.IsNormalized() || !zerogdscript.IsEqualApprox(n.Length(), 1) {
+		t.Errorf("Normalized() = %v has length %v, want 1", n, n.Length())
+	}
+	if !zerogdscript.IsEqualApprox(n.X, q.X) || !zerogdscript.IsEqualApprox(n.W, q.W) {
+		t.Errorf("Normalized() = %v, want %v", n, q)
+	}
+
+	scaled.Normalize()
+	if scaled != n {
+		t.Errorf("Normalize() = %v, want %v", scaled, n)
+	}
+
+	if z := ZERO().Normalized(); z != ZERO() {
+		t.Errorf("ZERO().Normalized() = %v, want ZERO()", z)
+	}
+}
+
+func TestQuaternion_FromBasis(t *testing.T) {
+	r := rng.New(1759)
+	random := func() float64 { return r.Randd()*2 - 1 }
+	for i := 0; i < 50; i++ {
+		axis := vector3.New(random(), random(), random())
+		if axis.LengthSquared() < 1e-4 {
+			continue
+		}
+		angle := random() * math.Pi
+		b := basis.FromAxisAndAngle(axis.Normalized().AsArray(), angle)
+
+		q := FromBasis(b)
+		if !q.IsNormalized() {
+			t.Errorf("FromBasis(%v) = %v is not normalized", b.Rows, q)
+		}
+		if want := Rotated(axis, angle); !zerogdscript.IsEqualApprox(math.Abs(q.Dot(want)), 1) {
+			t.Errorf("FromBasis(axis %v, angle %v) = %v, want %v or its negation", axis, angle, q, want)
+		}
+
+		got := q.ToBasis()
+		for row := range got.Rows {
+			for col := range got.Rows[row] {
+				if !zerogdscript.IsEqualApprox(got.Rows[row][col], b.Rows[row][col]) {
+					t.Fatalf("ToBasis(FromBasis(b)) = %v, want %v", got.Rows, b.Rows)
+				}
+			}
+		}
+	}
+
+	if got := IDENTITY().ToBasis(); got != basis.New() {
+		t.Errorf("IDENTITY().ToBasis() = %v, want the identity basis", got.Rows)
+	}
+	if got := FromBasis(basis.New()); got != IDENTITY() {
+		t.Errorf("FromBasis(identity) = %v, want IDENTITY()", got)
+	}
+	if got := ZERO().ToBasis(); got != basis.New() {
+		t.Errorf("ZERO().ToBasis() = %v, want the identity basis", got.Rows)
+	}
+}
+
+func TestQuaternion_GetAxis(t *testing.T) {
+	for _, tt := range []struct {
+		axis  vector3.Vector3
+		angle float64
+	}{
+		{vector3.New(0, 1, 0), math.Pi / 2},
+		{vector3.New(1, 0, 0), 0.1},
+		{vector3.New(1, 2, 3).Normalized(), 2},
+		{vector3.New(-1, 1, -1).Normalized(), math.Pi},
+		{vector3.New(0, 0, -1), 1.5 * math.Pi},
+	} {
+		q := Rotated(tt.axis, tt.angle)
+		if got := q.GetAngle(); math.Abs(got-tt.angle) > zerogdscript.CMP_EPSILON {
+			t.Errorf("Rotated(%v, %v).GetAngle() = %v", tt.axis, tt.angle, got)
+		}
+		if got := q.GetAxis(); !got.IsEqualApprox(tt.axis) {
+			t.Errorf("Rotated(%v, %v).GetAxis() = %v", tt.axis, tt.angle, got)
+		}
+	}
+
+	if got := IDENTITY().GetAxis(); got != vector3.New(0, 0, 1) {
+		t.Errorf("IDENTITY().GetAxis() = %v, want (0, 0, 1)", got)
+	}
+	if got := IDENTITY().GetAngle(); got != 0 {
+		t.Errorf("IDENTITY().GetAngle() = %v, want 0", got)
+	}
+	// A tiny rotation keeps its axis direction.
+	if got := Rotated(vector3.New(1, 0, 0), 1e-6).GetAxis(); !got.IsEqualApprox(vector3.New(1, 0, 0)) {
+		t.Errorf("GetAxis() of a tiny rotation = %v, want (1, 0, 0)", got)
+	}
+	// W slightly above 1 from rounding does not give NaN.
+	if got := New(0, 0, 0, 1+1e-12).GetAngle(); got != 0 {
+		t.Errorf("GetAngle() with W > 1 = %v, want 0", got)
+	}
+}
+
+func TestQuaternion_RotationVector(t *testing.T) {
+	quatEqual := func(a, b Quaternion) bool {
+		// q and -q are the same rotation.
+		return math.Abs(math.Abs(a.Dot(b))-1) < 1e-12
+	}
+
+	if got := FromRotationVector(vector3.Zero()); got != IDENTITY() {
+		t.Errorf("FromRotationVector(0) = %v, want IDENTITY", got)
+	}
+	if got := IDENTITY().ToRotationVector(); got != vector3.Zero() {
+		t.Errorf("IDENTITY().ToRotationVector() = %v, want 0", got)
+	}
+
+	axes := []vector3.Vector3{
+		vector3.New(1, 0, 0), vector3.New(0, -1, 0), vector3.New(1, 2, 3).Normalized(),
+	}
+	r := rng.New(11)
+	for i := 0; i < 20; i++ {
+		axes = append(axes, vector3.New(r.Randd()*2-1, r.Randd()*2-1, r.Randd()*2-1).Normalized())
+	}
+	for _, axis := range axes {
+		for _, angle := range []float64{1e-12, 1e-6, 1e-3, 0.5, 2, math.Pi - 1e-3, math.Pi - 1e-9} {
+			rv := axis.Mulf(angle)
+			q := FromRotationVector(rv)
+			if want := Rotated(axis, angle); !quatEqual(q, want) {
+				t.Errorf("FromRotationVector(%v) = %v, want %v", rv, q, want)
+			}
+			if !q.IsNormalized() {
+				t.Errorf("FromRotationVector(%v) = %v is not normalized", rv, q)
+			}
+			if got := q.ToRotationVector(); !got.IsEqualApproxTol(rv, 1e-9) {
+				t.Errorf("round trip of %v = %v", rv, got)
+			}
+			// The opposite quaternion gives the same vector.
+			neg := New(-q.X, -q.Y, -q.Z, -q.W)
+			if got := neg.ToRotationVector(); !got.IsEqualApproxTol(rv, 1e-9) {
+				t.Errorf("round trip of %v through -q = %v", rv, got)
+			}
+		}
+
+		// Exactly PI may come back as either of the two opposite vectors.
+		rv := axis.Mulf(math.Pi)
+		got := FromRotationVector(rv).ToRotationVector()
+		if !got.IsEqualApproxTol(rv, 1e-9) && !got.IsEqualApproxTol(rv.Mulf(-1), 1e-9) {
+			t.Errorf("round trip of %v = %v, want it or its opposite", rv, got)
+		}
+
+		// Angles past PI come back as the shorter rotation the other way.
+		rv = axis.Mulf(1.5 * math.Pi)
+		if got, want := FromRotationVector(rv).ToRotationVector(), axis.Mulf(-0.5*math.Pi); !got.IsEqualApproxTol(want, 1e-9) {
+			t.Errorf("round trip of %v = %v, want %v", rv, got, want)
+		}
+	}
+}
 
 func TestQuaternion_From(t *testing.T) {}
 
 func TestQuaternion_Between(t *testing.T) {}
+
+func TestQuaternion_InvalidInput(t *testing.T) {
+	isEqualApprox := func(a, b Quaternion) bool {
+		return zerogdscript.IsEqualApprox(a.X, b.X) && zerogdscript.IsEqualApprox(a.Y, b.Y) &&
+			zerogdscript.IsEqualApprox(a.Z, b.Z) && zerogdscript.IsEqualApprox(a.W, b.W)
+	}
+	up := vector3.New(0, 1, 0)
+	right := vector3.New(1, 0, 0)
+
+	tests := []struct {
+		name    string
+		checked func() (Quaternion, error)
+		plain   func() Quaternion
+		want    Quaternion
+		wantErr error
+	}{
+		{"Rotated non-normalized", func() (Quaternion, error) { return RotatedChecked(up.Mulf(4), 1) }, func() Quaternion { return Rotated(up.Mulf(4), 1) }, Rotated(up, 1), nil},
+		{"Rotated zero", func() (Quaternion, error) { return RotatedChecked(vector3.Zero(), 1) }, func() Quaternion { return Rotated(vector3.Zero(), 1) }, IDENTITY(), zerogdscript.ErrZeroLength},
+		{"Between non-normalized", func() (Quaternion, error) { return BetweenChecked(up.Mulf(2), right.Mulf(3)) }, func() Quaternion { return Between(up.Mulf(2), right.Mulf(3)) }, Between(up, right), nil},
+		{"Between zero", func() (Quaternion, error) { return BetweenChecked(vector3.Zero(), right) }, func() Quaternion { return Between(vector3.Zero(), right) }, IDENTITY(), zerogdscript.ErrZeroLength},
+	}
+	for _, tt := range tests {
+		got, err := tt.checked()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if !isEqualApprox(got, tt.want) {
+			t.Errorf("%s: checked = %v, want %v", tt.name, got, tt.want)
+		}
+		if plain := tt.plain(); !isEqualApprox(plain, tt.want) {
+			t.Errorf("%s: plain = %v, want %v", tt.name, plain, tt.want)
+		}
+	}
+}
+
+func TestQuaternion_Validate(t *testing.T) {
+	if err := IDENTITY().Validate(); err != nil {
+		t.Errorf("IDENTITY().Validate() = %v, want nil", err)
+	}
+	for _, tt := range []struct {
+		q       Quaternion
+		want    string
+		wantErr error
+	}{
+		{New(0, 0, 0, math.NaN()), "Quaternion.W: value is not finite: NaN", zerogdscript.ErrNonFinite},
+		{New(0, 0, 0, 2), "Quaternion: quaternion is not normalized", zerogdscript.ErrNotNormalized},
+		{ZERO(), "Quaternion: quaternion is not normalized", zerogdscript.ErrNotNormalized},
+	} {
+		err := tt.q.Validate()
+		if err == nil || err.Error() != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v.Validate() = %v, want %q", tt.q, err, tt.want)
+		}
+	}
+}
+
+func TestQuaternion_AsArray(t *testing.T) {
+	q := New(1, 2, 3, 4)
+	if got := q.AsArray(); got != [4]float64{1, 2, 3, 4} {
+		t.Errorf("AsArray() = %v, want [1 2 3 4]", got)
+	}
+	if got := FromArray(q.AsArray()); got != q {
+		t.Errorf("FromArray(AsArray()) = %v, want %v", got, q)
+	}
+}
@@ -0,0 +1,104 @@
+package rect2
+
+/**************************************************************************/
+/*  rect2.h                                                               */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"math"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// Rect2 is an axis-aligned rectangle given by its top-left Position and its Size.
+type Rect2 struct {
+	Position vector2.Vector2
+	Size     vector2.Vector2
+}
+
+// New returns the rectangle at (x, y) with the given width and height.
+func New(x, y, width, height float64) Rect2 {
+	return Rect2{Position: vector2.New(x, y), Size: vector2.New(width, height)}
+}
+
+// GetEnd returns the corner opposite Position.
+func (r Rect2) GetEnd() vector2.Vector2 {
+	return r.Position.Add(r.Size)
+}
+
+// GetCenter returns the center of the rectangle.
+func (r Rect2) GetCenter() vector2.Vector2 {
+	return r.Position.Add(r.Size.Mulf(0.5))
+}
+
+// HasPoint reports whether point lies inside the rectangle. Points on the
+// right and bottom edges are outside, as in Godot.
+func (r Rect2) HasPoint(point vector2.Vector2) bool {
+	end := r.GetEnd()
+	return point.X >= r.Position.X && point.Y >= r.Position.Y && point.X < end.X && point.Y < end.Y
+}
+
+// FromPoints returns the smallest rectangle containing every point. An empty
+// slice returns the zero rectangle.
+func FromPoints(points []vector2.Vector2) Rect2 {
+	if len(points) == 0 {
+		return Rect2{}
+	}
+	min, max := points[0], points[0]
+	for _, p := range points[1:] {
+		min = vector2.New(math.Min(min.X, p.X), math.Min(min.Y, p.Y))
+		max = vector2.New(math.Max(max.X, p.X), math.Max(max.Y, p.Y))
+	}
+	return Rect2{Position: min, Size: max.Sub(min)}
+}
+
+// Merge returns the smallest rectangle containing both r and b.
+func (r Rect2) Merge(b Rect2) Rect2 {
+	rEnd, bEnd := r.GetEnd(), b.GetEnd()
+	min := vector2.New(math.Min(r.Position.X, b.Position.X), math.Min(r.Position.Y, b.Position.Y))
+	max := vector2.New(math.Max(rEnd.X, bEnd.X), math.Max(rEnd.Y, bEnd.Y))
+	return Rect2{Position: min, Size: max.Sub(min)}
+}
+
+// MergeAll returns the smallest rectangle containing every rectangle in
+// rects. An empty slice returns the zero rectangle.
+func MergeAll(rects []Rect2) Rect2 {
+	if len(rects) == 0 {
+		return Rect2{}
+	}
+	res := rects[0]
+	for _, r := range rects[1:] {
+		res = res.Merge(r)
+	}
+	return res
+}
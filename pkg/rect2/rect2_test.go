@@ -0,0 +1,65 @@
+package rect2
+
+import (
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+func TestRect2_GetCenter(t *testing.T) {
+	r := New(10, 20, 100, 50)
+	if got, want := r.GetCenter(), vector2.New(60, 45); got != want {
+		t.Errorf("GetCenter() = %v, want %v", got, want)
+	}
+	if got, want := r.GetEnd(), vector2.New(110, 70); got != want {
+		t.Errorf("GetEnd() = %v, want %v", got, want)
+	}
+}
+
+func TestRect2_HasPoint(t *testing.T) {
+	r := New(0, 0, 10, 10)
+	if !r.HasPoint(vector2.New(0, 0)) || !r.HasPoint(vector2.New(5, 9.9)) {
+		t.Errorf("HasPoint() = false for points inside")
+	}
+	if r.HasPoint(vector2.New(10, 5)) || r.HasPoint(vector2.New(-1, 5)) {
+		t.Errorf("HasPoint() = true for points outside")
+	}
+}
+
+func TestRect2_FromPoints(t *testing.T) {
+	points := []vector2.Vector2{
+		vector2.New(3, -1), vector2.New(-2, 4), vector2.New(0.5, 0.5), vector2.New(7, 2), vector2.New(1, -6),
+	}
+	if got, want := FromPoints(points), New(-2, -6, 9, 10); got != want {
+		t.Errorf("FromPoints() = %v, want %v", got, want)
+	}
+	if got, want := FromPoints(points[:1]), New(3, -1, 0, 0); got != want {
+		t.Errorf("FromPoints(one point) = %v, want %v", got, want)
+	}
+	if got := FromPoints(nil); got != (Rect2{}) {
+		t.Errorf("FromPoints(nil) = %v, want the zero rectangle", got)
+	}
+}
+
+func TestRect2_MergeAll(t *testing.T) {
+	overlapping := []Rect2{New(0, 0, 4, 4), New(2, 2, 4, 4), New(1, -1, 1, 2)}
+	if got, want := MergeAll(overlapping), New(0, -1, 6, 7); got != want {
+		t.Errorf("MergeAll(overlapping) = %v, want %v", got, want)
+	}
+	disjoint := []Rect2{New(-10, -10, 1, 1), New(5, 5, 2, 3)}
+	if got, want := MergeAll(disjoint), New(-10, -10, 17, 18); got != want {
+		t.Errorf("MergeAll(disjoint) = %v, want %v", got, want)
+	}
+	if got, want := New(0, 0, 1, 1).Merge(New(-1, 2, 1, 1)), New(-1, 0, 2, 3); got != want {
+		t.Errorf("Merge() = %v, want %v", got, want)
+	}
+	if got := MergeAll(nil); got != (Rect2{}) {
+		t.Errorf("MergeAll(nil) = %v, want the zero rectangle", got)
+	}
+	// Merging the bounds of point sets bounds their union.
+	a := []vector2.Vector2{vector2.New(0, 0), vector2.New(1, 3)}
+	b := []vector2.Vector2{vector2.New(-2, 1), vector2.New(4, 2)}
+	if got, want := FromPoints(a).Merge(FromPoints(b)), FromPoints(append(a, b...)); got != want {
+		t.Errorf("merged bounds = %v, want %v", got, want)
+	}
+}
@@ -0,0 +1,208 @@
+package rng
+
+/**************************************************************************/
+/*  random_number_generator.h                                             */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"fmt"
+	"math"
+	"sync"
+	"time"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+)
+
+// RandomNumberGenerator mirrors Godot's RandomNumberGenerator object on top of
+// the PCG32 Rng. Seeded the same way, it returns the same Randi, Randf and
+// RandiRange sequences as Godot 4.2, so procedural generation can be
+// cross-checked between Go and GDScript.
+// A RandomNumberGenerator is not safe for concurrent use.
+type RandomNumberGenerator struct {
+	randbase Rng
+}
+
+// NewRandomNumberGenerator returns a randomized generator, like
+// RandomNumberGenerator.new() in Godot 4. Call SetSeed or SetState for a
+// reproducible sequence.
+func NewRandomNumberGenerator() *RandomNumberGenerator {
+	r := &RandomNumberGenerator{}
+	r.randbase.Seed(DefaultSeed)
+	r.Randomize()
+	return r
+}
+
+// SetSeed restarts the sequence for seed.
+func (r *RandomNumberGenerator) SetSeed(seed uint64) {
+	r.randbase.Seed(seed)
+}
+
+// GetSeed returns the seed, with the same caveats as Rng.GetSeed.
+func (r *RandomNumberGenerator) GetSeed() uint64 {
+	return r.randbase.GetSeed()
+}
+
+// GetState returns the internal PCG state. Passing it to SetState later
+// resumes the sequence from this point, which is how to save and restore a
+// generator mid-sequence.
+func (r *RandomNumberGenerator) GetState() uint64 {
+	return r.randbase.state
+}
+
+// SetState restores a state returned by GetState. Unlike SetSeed, the value
+// is used as is, so it should come from GetState rather than be made up.
+func (r *RandomNumberGenerator) SetState(state uint64) {
+	r.randbase.state = state
+}
+
+// Randomize seeds the generator from the current time and state. The result
+// is not reproducible.
+func (r *RandomNumberGenerator) Randomize() {
+	r.randbase.Seed(uint64(time.Now().UnixMicro())*r.randbase.state + DefaultInc)
+}
+
+// Randi returns a uniformly distributed 32-bit value.
+func (r *RandomNumberGenerator) Randi() uint32 {
+	return r.randbase.Rand()
+}
+
+// Randf returns a float32 in [0, 1].
+func (r *RandomNumberGenerator) Randf() float32 {
+	return r.randbase.Randf()
+}
+
+// RandfRange returns a value in [from, to]. It draws a single-precision Randf
+// like Godot's default build, so the value matches GDScript's randf_range up
+// to float32 rounding.
+func (r *RandomNumberGenerator) RandfRange(from, to float64) float64 {
+	return float64(r.randbase.Randf())*(to-from) + from
+}
+
+// Randfn returns a normally distributed value with the given mean and
+// standard deviation, using the Box-Muller transform on two Randf draws as
+// Godot's default build does.
+func (r *RandomNumberGenerator) Randfn(mean, deviation float64) float64 {
+	temp := float64(r.randbase.Randf())
+	if temp < zerogdscript.CMP_EPSILON {
+		// Keep log away from 0 so the result never becomes Inf or NaN.
+		temp += zerogdscript.CMP_EPSILON
+	}
+	return mean + deviation*(math.Cos(zerogdscript.TAU*float64(r.randbase.Randf()))*math.Sqrt(-2.0*math.Log(temp)))
+}
+
+// RandWeighted returns an index into weights picked with a probability
+// proportional to its weight, like Godot 4.3's rand_weighted. It returns -1
+// if the weights are empty, all zero, or contain a negative or non-finite
+// value; RandWeightedChecked says which.
+func (r *RandomNumberGenerator) RandWeighted(weights []float64) int {
+	i, _ := r.RandWeightedChecked(weights)
+	return i
+}
+
+// RandWeightedChecked is like RandWeighted but returns ErrInvalidWeights
+// instead of -1. Negative weights are rejected rather than treated as zero,
+// since they usually mean a bug upstream and would otherwise skew the odds
+// silently.
+func (r *RandomNumberGenerator) RandWeightedChecked(weights []float64) (int, error) {
+	sum := 0.0
+	for i, w := range weights {
+		if w < 0 || math.IsNaN(w) || math.IsInf(w, 0) {
+			return -1, fmt.Errorf("weight %d is %v: %w", i, w, zerogdscript.ErrInvalidWeights)
+		}
+		sum += w
+	}
+	if sum == 0 {
+		return -1, zerogdscript.ErrInvalidWeights
+	}
+	remaining := float64(r.randbase.Randf()) * sum
+	for i, w := range weights {
+		remaining -= w
+		if remaining < 0 {
+			return i, nil
+		}
+	}
+	// Rounding left a sliver at the end; pick the last positive weight.
+	for i := len(weights) - 1; i >= 0; i-- {
+		if weights[i] > 0 {
+			return i, nil
+		}
+	}
+	return -1, zerogdscript.ErrInvalidWeights
+}
+
+// PickRandom returns a uniformly chosen element of items, like Godot's
+// Array.pick_random. It returns the zero value for an empty slice.
+func PickRandom[T any](r *RandomNumberGenerator, items []T) T {
+	if len(items) == 0 {
+		var zero T
+		return zero
+	}
+	return items[r.randbase.Rand()%uint32(len(items))]
+}
+
+// RandiRange returns an integer in [from, to], both ends included. The bounds
+// may be given in either order. As in Godot, the span must fit in 32 bits.
+func (r *RandomNumberGenerator) RandiRange(from, to int) int {
+	if from == to {
+		return from
+	}
+	span := from - to
+	if span < 0 {
+		span = -span
+	}
+	r.randbase.seed = r.randbase.state
+	return int(r.randbase.RandBounded(uint32(span)+1)) + min(from, to)
+}
+
+// defaultGenerator backs the package-level functions, like Godot's global
+// random functions.
+var defaultGenerator = struct {
+	sync.Mutex
+	rng *RandomNumberGenerator
+}{rng: NewRandomNumberGenerator()}
+
+// Seed seeds the generator behind the package-level functions.
+func Seed(seed uint64) {
+	defaultGenerator.Lock()
+	defer defaultGenerator.Unlock()
+	defaultGenerator.rng.SetSeed(seed)
+}
+
+// Randfn is RandomNumberGenerator.Randfn on a shared, randomized generator.
+// It is safe for concurrent use, but the sequence is only reproducible after
+// Seed when a single goroutine draws from it.
+func Randfn(mean, deviation float64) float64 {
+	defaultGenerator.Lock()
+	defer defaultGenerator.Unlock()
+	return defaultGenerator.rng.Randfn(mean, deviation)
+}
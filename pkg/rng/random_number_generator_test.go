@@ -0,0 +1,214 @@
+package rng
+
+import (
+	"errors"
+	"math"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+)
+
+func TestRandomNumberGenerator_SetSeed(t *testing.T) {
+	a := NewRandomNumberGenerator()
+	a.SetSeed(1234)
+	b := New(1234)
+	for i := 0; i < 10; i++ {
+		if got, want := a.Randi(), b.Rand(); got != want {
+			t.Fatalf("Randi #%d = %d, want %d from Rng with the same seed", i, got, want)
+		}
+	}
+	a.SetSeed(1234)
+	if got := a.GetSeed(); got != 1234 {
+		t.Errorf("GetSeed() = %d, want 1234", got)
+	}
+}
+
+func TestRandomNumberGenerator_Golden(t *testing.T) {
+	// Reference sequences for seed 1234 from a C transcription of Godot
+	// 4.2's RandomPCG (core/math/random_pcg.h and thirdparty/misc/pcg.cpp).
+	// In GDScript: var rng = RandomNumberGenerator.new(); rng.seed = 1234.
+	r := NewRandomNumberGenerator()
+
+	r.SetSeed(1234)
+	for i, want := range []uint32{435017838, 3680626977, 1396107827, 1732080859, 89387842, 2858954249} {
+		if got := r.Randi(); got != want {
+			t.Errorf("randi() #%d = %d, want %d", i, got, want)
+		}
+	}
+
+	r.SetSeed(1234)
+	for i, want := range []uint32{0x3ddb61e9, 0x3ee73d75, 0x3caa682e, 0x3f27b249, 0x3f141b27, 0x3f6eeb69} {
+		if got := r.Randf(); math.Float32bits(got) != want {
+			t.Errorf("randf() #%d = %v, want %v", i, got, math.Float32frombits(want))
+		}
+	}
+
+	r.SetSeed(1234)
+	for i, want := range []int{-7, -4, -8, 9, 9, 1, 3, -9} {
+		if got := r.RandiRange(-10, 10); got != want {
+			t.Errorf("randi_range(-10, 10) #%d = %d, want %d", i, got, want)
+		}
+	}
+
+	r.SetSeed(1234)
+	for i, want := range []int{39, 78, 28, 60, 43, 50} {
+		if got := r.RandiRange(100, 1); got != want {
+			t.Errorf("randi_range(100, 1) #%d = %d, want %d", i, got, want)
+		}
+	}
+	if got, want := r.GetSeed(), uint64(15075088680388343439); got != want {
+		t.Errorf("seed after randi_range = %d, want %d", got, want)
+	}
+}
+
+func TestRandomNumberGenerator_State(t *testing.T) {
+	r := NewRandomNumberGenerator()
+	r.SetSeed(77)
+	r.Randi()
+	saved := r.GetState()
+	want := []uint32{r.Randi(), r.Randi(), r.Randi()}
+	r.SetSeed(5)
+	r.SetState(saved)
+	for i, w := range want {
+		if got := r.Randi(); got != w {
+			t.Errorf("Randi #%d after SetState = %d, want %d", i, got, w)
+		}
+	}
+}
+
+func TestRandomNumberGenerator_Randomize(t *testing.T) {
+	r := NewRandomNumberGenerator()
+	r.SetSeed(1)
+	before := r.GetState()
+	r.Randomize()
+	if r.GetState() == before {
+		t.Errorf("Randomize() left the state unchanged")
+	}
+}
+
+func TestRandomNumberGenerator_RandfRange(t *testing.T) {
+	r := NewRandomNumberGenerator()
+	r.SetSeed(3)
+	for i := 0; i < 1000; i++ {
+		if v := r.RandfRange(-2, 5); v < -2 || v > 5 {
+			t.Fatalf("RandfRange(-2, 5) = %v", v)
+		}
+		if v := r.Randf(); v < 0 || v > 1 {
+			t.Fatalf("Randf() = %v", v)
+		}
+	}
+}
+
+func TestRandomNumberGenerator_RandiRange(t *testing.T) {
+	r := NewRandomNumberGenerator()
+	r.SetSeed(11)
+	seen := map[int]bool{}
+	for i := 0; i < 1000; i++ {
+		v := r.RandiRange(-3, 3)
+		if v < -3 || v > 3 {
+			t.Fatalf("RandiRange(-3, 3) = %d", v)
+		}
+		seen[v] = true
+	}
+	if len(seen) != 7 {
+		t.Errorf("RandiRange(-3, 3) produced %d distinct values, want all 7", len(seen))
+	}
+	for i := 0; i < 100; i++ {
+		if v := r.RandiRange(10, 8); v < 8 || v > 10 {
+			t.Fatalf("RandiRange(10, 8) = %d", v)
+		}
+	}
+	if v := r.RandiRange(4, 4); v != 4 {
+		t.Errorf("RandiRange(4, 4) = %d, want 4", v)
+	}
+}
+
+func TestRandomNumberGenerator_Randfn(t *testing.T) {
+	r := NewRandomNumberGenerator()
+	r.SetSeed(2024)
+	const n = 100000
+	const mean, deviation = 3.0, 2.0
+	sum, sumSq := 0.0, 0.0
+	for i := 0; i < n; i++ {
+		v := r.Randfn(mean, deviation)
+		if math.IsNaN(v) || math.IsInf(v, 0) {
+			t.Fatalf("Randfn(%v, %v) = %v", mean, deviation, v)
+		}
+		sum += v
+		sumSq += v * v
+	}
+	gotMean := sum / n
+	gotDev := math.Sqrt(sumSq/n - gotMean*gotMean)
+	if math.Abs(gotMean-mean) > 0.03 || math.Abs(gotDev-deviation) > 0.03 {
+		t.Errorf("Randfn sample mean %v, deviation %v, want about %v and %v", gotMean, gotDev, mean, deviation)
+	}
+
+	a, b := NewRandomNumberGenerator(), NewRandomNumberGenerator()
+	a.SetSeed(9)
+	b.SetSeed(9)
+	for i := 0; i < 100; i++ {
+		if x, y := a.Randfn(0, 1), b.Randfn(0, 1); x != y {
+			t.Fatalf("Randfn #%d with the same seed: %v and %v", i, x, y)
+		}
+	}
+}
+
+func TestRng_Randfn(t *testing.T) {
+	Seed(5)
+	first := []float64{Randfn(0, 1), Randfn(0, 1), Randfn(0, 1)}
+	Seed(5)
+	for i, want := range first {
+		if got := Randfn(0, 1); got != want {
+			t.Errorf("Randfn #%d after reseeding = %v, want %v", i, got, want)
+		}
+	}
+	if got := Randfn(7, 0); got != 7 {
+		t.Errorf("Randfn(7, 0) = %v, want 7", got)
+	}
+}
+
+func TestRandomNumberGenerator_RandWeighted(t *testing.T) {
+	r := NewRandomNumberGenerator()
+	r.SetSeed(1759)
+	weights := []float64{1, 0, 3, 6}
+	counts := make([]int, len(weights))
+	const n = 100000
+	for i := 0; i < n; i++ {
+		counts[r.RandWeighted(weights)]++
+	}
+	for i, w := range weights {
+		if got, want := float64(counts[i])/n, w/10; math.Abs(got-want) > 0.01 {
+			t.Errorf("index %d picked %.4f of the time, want %.4f", i, got, want)
+		}
+	}
+
+	for _, bad := range [][]float64{nil, {}, {0, 0}, {1, -1, 2}, {1, math.NaN()}, {math.Inf(1), 1}} {
+		if got := r.RandWeighted(bad); got != -1 {
+			t.Errorf("RandWeighted(%v) = %d, want -1", bad, got)
+		}
+		if got, err := r.RandWeightedChecked(bad); got != -1 || !errors.Is(err, zerogdscript.ErrInvalidWeights) {
+			t.Errorf("RandWeightedChecked(%v) = %d, %v, want -1, ErrInvalidWeights", bad, got, err)
+		}
+	}
+	if got, err := r.RandWeightedChecked([]float64{0, 2}); got != 1 || err != nil {
+		t.Errorf("RandWeightedChecked([0 2]) = %d, %v, want 1, nil", got, err)
+	}
+}
+
+func TestRandomNumberGenerator_PickRandom(t *testing.T) {
+	r := NewRandomNumberGenerator()
+	r.SetSeed(3)
+	items := []string{"a", "b", "c"}
+	seen := map[string]int{}
+	for i := 0; i < 3000; i++ {
+		seen[PickRandom(r, items)]++
+	}
+	for _, it := range items {
+		if seen[it] < 900 || seen[it] > 1100 {
+			t.Errorf("%q picked %d times out of 3000", it, seen[it])
+		}
+	}
+	if got := PickRandom(r, []int(nil)); got != 0 {
+		t.Errorf("PickRandom(nil) = %v, want 0", got)
+	}
+}
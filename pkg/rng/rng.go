@@ -0,0 +1,209 @@
+package rng
+
+/**************************************************************************/
+/*  random_pcg.h                                                          */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"math"
+	"math/bits"
+)
+
+// DefaultSeed is the seed Godot's RandomPCG uses when none is given.
+const DefaultSeed uint64 = 12047754176567800795
+
+// DefaultInc is the PCG stream selector Godot's RandomPCG uses.
+const DefaultInc uint64 = 1442695040888963407
+
+// Rng is a PCG32 pseudo-random number generator, ported from Godot's RandomPCG.
+// Given the same seed it produces the same sequence on every platform.
+// An Rng is not safe for concurrent use.
+type Rng struct {
+	state uint64
+	inc   uint64
+	seed  uint64
+}
+
+// New returns a generator seeded with seed.
+func New(seed uint64) *Rng {
+	r := &Rng{}
+	r.Seed(seed)
+	return r
+}
+
+// Seed resets the generator to the start of the sequence for seed.
+func (r *Rng) Seed(seed uint64) {
+	r.seed = seed
+	r.srandom(seed, DefaultInc)
+}
+
+// GetSeed returns the value last recorded as the seed. Like Godot's
+// current_seed, this is the seed passed to New or Seed only until the first
+// call to Rand; after that it is the internal state as it was before the most
+// recent Rand call.
+func (r *Rng) GetSeed() uint64 {
+	return r.seed
+}
+
+// Rand returns a uniformly distributed 32-bit value.
+// It records the current state as the seed before advancing, see GetSeed.
+func (r *Rng) Rand() uint32 {
+	r.seed = r.state
+	return r.next()
+}
+
+// RandBounded returns a uniformly distributed value in [0, bound).
+// It returns 0 when bound is 0.
+func (r *Rng) RandBounded(bound uint32) uint32 {
+	if bound == 0 {
+		return 0
+	}
+	threshold := -bound % bound
+	for {
+		v := r.next()
+		if v >= threshold {
+			return v % bound
+		}
+	}
+}
+
+// Randd returns a float64 in [0, 1].
+func (r *Rng) Randd() float64 {
+	// Sample rand() as the fraction part of an infinite binary number, setting the
+	// MSB and LSB of the significand and using the leading zeros of another draw as
+	// the exponent offset. Values below 2^-96 are floored to 0.
+	protoExpOffset := r.Rand()
+	if protoExpOffset == 0 {
+		return 0
+	}
+	significand := uint64(r.Rand())<<32 | uint64(r.Rand()) | 0x8000000000000001
+	return math.Ldexp(float64(significand), -64-bits.LeadingZeros32(protoExpOffset))
+}
+
+// Randf returns a float32 in [0, 1].
+func (r *Rng) Randf() float32 {
+	protoExpOffset := r.Rand()
+	if protoExpOffset == 0 {
+		return 0
+	}
+	return float32(math.Ldexp(float64(float32(r.Rand()|0x80000001)), -32-bits.LeadingZeros32(protoExpOffset)))
+}
+
+// srandom is pcg32_srandom_r.
+func (r *Rng) srandom(initState, initSeq uint64) {
+	r.state = 0
+	r.inc = initSeq<<1 | 1
+	r.next()
+	r.state += initState
+	r.next()
+}
+
+// next is pcg32_random_r.
+func (r *Rng) next() uint32 {
+	old := r.state
+	r.state = old*6364136223846793005 + r.inc
+	xorShifted := uint32(((old >> 18) ^ old) >> 27)
+	rot := uint32(old >> 59)
+	return bits.RotateLeft32(xorShifted, -int(rot))
+}
+
+// RandFromSeed is Godot's rand_from_seed: it seeds a fresh generator with
+// *seed, returns its first Rand and stores the generator's seed back in *seed
+// (see GetSeed), so repeated calls walk a reproducible sequence without
+// keeping an Rng around. The values match Godot's on every platform.
+func RandFromSeed(seed *uint64) uint32 {
+	r := New(*seed)
+	v := r.Rand()
+	*seed = r.GetSeed()
+	return v
+}
+
+// HashRandf returns a float64 in [0, 1) determined only by seed and index,
+// for stateless per-cell randomness such as procedural placement. It is safe
+// for concurrent use.
+//
+// The result is part of the API contract and is the same on every platform:
+// with splitmix(z) the SplitMix64 output for state z (the finalizer applied
+// to z + 0x9E3779B97F4A7C15), it is the top 53 bits of
+// splitmix(splitmix(seed) ^ index) divided by 2^53. Changing this is a
+// breaking change.
+func HashRandf(seed, index uint64) float64 {
+	x := splitmix64(splitmix64(seed) ^ index)
+	return float64(x>>11) * 0x1p-53
+}
+
+// splitmix64 returns the SplitMix64 output for state z.
+func splitmix64(z uint64) uint64 {
+	z += 0x9E3779B97F4A7C15
+	z = (z ^ (z >> 30)) * 0xBF58476D1CE4E5B9
+	z = (z ^ (z >> 27)) * 0x94D049BB133111EB
+	return z ^ (z >> 31)
+}
+
+// Shuffle shuffles s in place using the Fisher–Yates algorithm.
+func Shuffle[T any](rng *Rng, s []T) {
+	for i := len(s) - 1; i >= 1; i-- {
+		j := rng.RandBounded(uint32(i + 1))
+		s[i], s[j] = s[j], s[i]
+	}
+}
+
+// WeightedChoice returns an index into weights picked with a probability
+// proportional to its weight. Negative weights are treated as zero.
+// It returns -1 if no weight is positive.
+func WeightedChoice(rng *Rng, weights []float64) int {
+	total := 0.0
+	last := -1
+	for i, w := range weights {
+		if w > 0 {
+			total += w
+			last = i
+		}
+	}
+	if last < 0 {
+		return -1
+	}
+
+	r := rng.Randd() * total
+	cumulative := 0.0
+	for i, w := range weights {
+		if w <= 0 {
+			continue
+		}
+		cumulative += w
+		if r < cumulative {
+			return i
+		}
+	}
+	return last
+}
@@ -0,0 +1,193 @@
+package rng
+
+import (
+	"math"
+	"testing"
+)
+
+func TestRng_next(t *testing.T) {
+	// Reference output of the PCG32 demo program for initstate 42, initseq 54.
+	r := &Rng{}
+	r.srandom(42, 54)
+	want := []uint32{0xa15c02b7, 0x7b47f409, 0xba1d3330, 0x83d2f293, 0xbfa4784b, 0xcbed606e}
+	for i, w := range want {
+		if got := r.next(); got != w {
+			t.Errorf("next() #%d = %#x, want %#x", i, got, w)
+		}
+	}
+}
+
+func TestRng_Seed(t *testing.T) {
+	a := New(1234)
+	b := New(1234)
+	for i := 0; i < 100; i++ {
+		if a.Rand() != b.Rand() {
+			t.Fatalf("generators with the same seed diverged at draw %d", i)
+		}
+	}
+	a.Seed(1234)
+	c := New(1234)
+	if a.Rand() != c.Rand() {
+		t.Errorf("Seed() did not restart the sequence")
+	}
+}
+
+func TestRng_GetSeed(t *testing.T) {
+	r := New(1234)
+	if got := r.GetSeed(); got != 1234 {
+		t.Errorf("GetSeed() after New = %d, want 1234", got)
+	}
+	state := r.state
+	r.Rand()
+	if got := r.GetSeed(); got != state {
+		t.Errorf("GetSeed() after Rand = %d, want the pre-Rand state %d", got, state)
+	}
+}
+
+func TestRng_RandFromSeed(t *testing.T) {
+	// Golden values: changing them breaks saved procedural content.
+	for _, tt := range []struct {
+		seed uint64
+		want []uint32
+		next []uint64
+	}{
+		{0, []uint32{881477183, 1863048451, 2268031831}, []uint64{10116158231463745938, 6554802779054245436, 7473075071536772638}},
+		{12345, []uint32{1321476956, 2124323794, 1355548535}, []uint64{10694831691143060119, 9574286409965956893, 12643709795513844907}},
+		{0xdeadbeef, []uint32{3701158945, 1193584962, 463794344}, []uint64{2244704397813119637, 7085492308299272387, 7095505410444937433}},
+	} {
+		seed := tt.seed
+		for i := range tt.want {
+			if got := RandFromSeed(&seed); got != tt.want[i] || seed != tt.next[i] {
+				t.Errorf("seed %d draw %d: RandFromSeed = %d with seed %d, want %d with seed %d", tt.seed, i, got, seed, tt.want[i], tt.next[i])
+			}
+		}
+	}
+
+	// It matches the first draw of a generator with the same seed.
+	seed := uint64(777)
+	if got, want := RandFromSeed(&seed), New(777).Rand(); got != want {
+		t.Errorf("RandFromSeed(777) = %d, want New(777).Rand() = %d", got, want)
+	}
+}
+
+func TestRng_HashRandf(t *testing.T) {
+	// Golden values: changing them is a breaking change.
+	for _, tt := range []struct {
+		seed, index uint64
+		want        float64
+	}{
+		{0, 0, 0.6524484863740322},
+		{0, 1, 0.03401170130434639},
+		{1, 0, 0.36818951565166946},
+		{42, 7, 0.08603176010658542},
+		{42, 8, 0.9524581444081955},
+		{math.MaxUint64, math.MaxUint64, 0.3868572857242202},
+	} {
+		if got := HashRandf(tt.seed, tt.index); got != tt.want {
+			t.Errorf("HashRandf(%d, %d) = %v, want %v", tt.seed, tt.index, got, tt.want)
+		}
+	}
+
+	// Roughly uniform over [0, 1).
+	const n = 10000
+	var buckets [10]int
+	for i := uint64(0); i < n; i++ {
+		v := HashRandf(99, i)
+		if v < 0 || v >= 1 {
+			t.Fatalf("HashRandf(99, %d) = %v, outside [0, 1)", i, v)
+		}
+		buckets[int(v*10)]++
+	}
+	for i, c := range buckets {
+		if c < n/10*8/10 || c > n/10*12/10 {
+			t.Errorf("bucket %d has %d of %d values, want about %d", i, c, n, n/10)
+		}
+	}
+}
+
+func TestRng_RandBounded(t *testing.T) {
+	r := New(7)
+	for i := 0; i < 1000; i++ {
+		if v := r.RandBounded(6); v >= 6 {
+			t.Fatalf("RandBounded(6) = %d", v)
+		}
+	}
+	if v := r.RandBounded(0); v != 0 {
+		t.Errorf("RandBounded(0) = %d, want 0", v)
+	}
+}
+
+func TestRng_Randd(t *testing.T) {
+	r := New(99)
+	sum := 0.0
+	n := 10000
+	for i := 0; i < n; i++ {
+		v := r.Randd()
+		if v < 0 || v > 1 {
+			t.Fatalf("Randd() = %v, want a value in [0, 1]", v)
+		}
+		sum += v
+	}
+	if mean := sum / float64(n); math.Abs(mean-0.5) > 0.02 {
+		t.Errorf("Randd() mean = %v, want about 0.5", mean)
+	}
+}
+
+func TestRng_Shuffle(t *testing.T) {
+	shuffled := func(seed uint64) []int {
+		s := []int{0, 1, 2, 3, 4, 5, 6, 7, 8, 9}
+		Shuffle(New(seed), s)
+		return s
+	}
+
+	a, b := shuffled(42), shuffled(42)
+	for i := range a {
+		if a[i] != b[i] {
+			t.Fatalf("same seed produced different shuffles: %v and %v", a, b)
+		}
+	}
+
+	seen := make([]bool, len(a))
+	for _, v := range a {
+		seen[v] = true
+	}
+	for i, ok := range seen {
+		if !ok {
+			t.Errorf("shuffle lost element %d: %v", i, a)
+		}
+	}
+
+	identity := true
+	for i, v := range a {
+		if v != i {
+			identity = false
+		}
+	}
+	if identity {
+		t.Errorf("shuffle left the slice in order")
+	}
+}
+
+func TestRng_WeightedChoice(t *testing.T) {
+	r := New(2024)
+	weights := []float64{1, 0, 3, 6}
+	counts := make([]int, len(weights))
+	n := 100000
+	for i := 0; i < n; i++ {
+		counts[WeightedChoice(r, weights)]++
+	}
+	for i, w := range weights {
+		got := float64(counts[i]) / float64(n)
+		want := w / 10
+		if math.Abs(got-want) > 0.01 {
+			t.Errorf("index %d picked %.4f of the time, want %.4f", i, got, want)
+		}
+	}
+
+	if got := WeightedChoice(r, []float64{0, -1}); got != -1 {
+		t.Errorf("WeightedChoice() with no positive weight = %d, want -1", got)
+	}
+	if got := WeightedChoice(r, nil); got != -1 {
+		t.Errorf("WeightedChoice(nil) = %d, want -1", got)
+	}
+}
@@ -0,0 +1,41 @@
+// Package stats provides small summary statistics over float64 slices.
+package stats
+
+import (
+	"math"
+	"sort"
+)
+
+// Percentile returns the p-th percentile of values, with p in [0, 100],
+// linearly interpolating between the two closest ranks. p is clamped to
+// that range. The caller's slice is left in its original order. An empty
+// slice or a NaN p returns NaN.
+func Percentile(values []float64, p float64) float64 {
+	if len(values) == 0 {
+		return math.NaN()
+	}
+	sorted := make([]float64, len(values))
+	copy(sorted, values)
+	sort.Float64s(sorted)
+	return percentileSorted(sorted, p)
+}
+
+// Median returns the 50th percentile of values; an even count averages the
+// two middle values. An empty slice returns NaN.
+func Median(values []float64) float64 {
+	return Percentile(values, 50)
+}
+
+func percentileSorted(sorted []float64, p float64) float64 {
+	if math.IsNaN(p) {
+		return math.NaN()
+	}
+	p = math.Max(0, math.Min(100, p))
+	rank := p / 100 * float64(len(sorted)-1)
+	lo := int(math.Floor(rank))
+	hi := int(math.Ceil(rank))
+	if lo == hi {
+		return sorted[lo]
+	}
+	return sorted[lo] + (sorted[hi]-sorted[lo])*(rank-float64(lo))
+}
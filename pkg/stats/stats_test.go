@@ -0,0 +1,58 @@
+package stats
+
+import (
+	"math"
+	"reflect"
+	"testing"
+)
+
+func TestPercentile(t *testing.T) {
+	values := []float64{15, 20, 35, 40, 50}
+	orig := append([]float64(nil), values...)
+	for _, tt := range []struct {
+		p, want float64
+	}{
+		{0, 15},
+		{50, 35},
+		{100, 50},
+		{40, 29},
+		{90, 46},
+		{-10, 15},
+		{150, 50},
+	} {
+		if got := Percentile(values, tt.p); math.Abs(got-tt.want) > 1e-12 {
+			t.Errorf("Percentile(%v) = %v, want %v", tt.p, got, tt.want)
+		}
+	}
+
+	unsorted := []float64{9, 1, 5, 3, 7}
+	before := append([]float64(nil), unsorted...)
+	if got := Percentile(unsorted, 25); got != 3 {
+		t.Errorf("Percentile(unsorted, 25) = %v, want 3", got)
+	}
+	if !reflect.DeepEqual(unsorted, before) {
+		t.Errorf("input reordered to %v, want %v", unsorted, before)
+	}
+	if !reflect.DeepEqual(values, orig) {
+		t.Errorf("input reordered to %v, want %v", values, orig)
+	}
+
+	if got := Percentile(nil, 50); !math.IsNaN(got) {
+		t.Errorf("Percentile(nil) = %v, want NaN", got)
+	}
+	if got := Percentile([]float64{1, 2, 3}, math.NaN()); !math.IsNaN(got) {
+		t.Errorf("Percentile(p = NaN) = %v, want NaN", got)
+	}
+}
+
+func TestMedian(t *testing.T) {
+	if got := Median([]float64{3, 1, 2}); got != 2 {
+		t.Errorf("odd Median = %v, want 2", got)
+	}
+	if got := Median([]float64{4, 1, 3, 2}); got != 2.5 {
+		t.Errorf("even Median = %v, want 2.5", got)
+	}
+	if got := Median([]float64{7}); got != 7 {
+		t.Errorf("single Median = %v, want 7", got)
+	}
+}
@@ -35,6 +35,7 @@ package transform2d
 /**************************************************************************/
 
 import (
+	"fmt"
 	"math"
 
 	zerogdscript "github.com/Anaxarchus/zero-gdscript"
@@ -53,8 +54,8 @@ func NewTransform2D(rot float64, pos vector2.Vector2) Transform2D {
 
 	return Transform2D{
 		Columns: [3]vector2.Vector2{
-			vector2.New(cr, -sr),
-			vector2.New(sr, cr),
+			vector2.New(cr, sr),
+			vector2.New(-sr, cr),
 			pos,
 		},
 	}
@@ -112,6 +113,40 @@ func (t Transform2D) Translated(p_offset vector2.Vector2) Transform2D {
 	return Transform2DFromColumns(t.Columns[0], t.Columns[1], t.Columns[2].Add(p_offset))
 }
 
+// InterpolateWith returns the transform interpolated between t and to by
+// weight. Rotation is interpolated along the shortest arc, and scale and
+// origin linearly. Skew is not preserved.
+func (t Transform2D) InterpolateWith(to Transform2D, weight float64) Transform2D {
+	return t.InterpolateWithEasing(to, weight, nil, nil)
+}
+
+// InterpolateWithEasing is like InterpolateWith, but passes weight through
+// posEase for the origin and through rotEase for the rotation and scale, so
+// that each can follow its own curve. A nil ease is linear. Weights of exactly
+// 0 and 1 return t and to unchanged.
+func (t Transform2D) InterpolateWithEasing(to Transform2D, weight float64, posEase, rotEase func(float64) float64) Transform2D {
+	if weight == 0 {
+		return t
+	} else if weight == 1 {
+		return to
+	}
+
+	posWeight, rotWeight := weight, weight
+	if posEase != nil {
+		posWeight = posEase(weight)
+	}
+	if rotEase != nil {
+		rotWeight = rotEase(weight)
+	}
+
+	rot := zerogdscript.LerpAngle(t.GetRotation(), to.GetRotation(), rotWeight)
+	scale := t.GetScale().Lerp(to.GetScale(), rotWeight)
+	res := NewTransform2D(rot, t.Columns[2].Lerp(to.Columns[2], posWeight))
+	res.Columns[0] = res.Columns[0].Mulf(scale.X)
+	res.Columns[1] = res.Columns[1].Mulf(scale.Y)
+	return res
+}
+
 // ToLocal converts a point from global space to local space.
 func (t Transform2D) ToLocal(point vector2.Vector2) vector2.Vector2 {
 	return t.AffineInverse().Xform(point)
@@ -123,21 +158,31 @@ func (t Transform2D) ToGlobal(point vector2.Vector2) vector2.Vector2 {
 }
 
 // Inverse returns the inverse of the current transformation if it's a pure rotation.
+// It transposes the basis, so it never fails but is wrong under scale; use
+// AffineInverse for those.
 func (t Transform2D) Inverse() Transform2D {
 	// This assumes the matrix is a rotation matrix (no scaling).
-	if t.determinant() == 0 {
-		return Transform2D{}
-	}
-	return Transform2D{
+	inv := Transform2D{
 		Columns: [3]vector2.Vector2{
 			vector2.New(t.Columns[0].X, t.Columns[1].X),
 			vector2.New(t.Columns[0].Y, t.Columns[1].Y),
-			vector2.New(-t.tdotx(t.Columns[2]), -t.tdoty(t.Columns[2])),
 		},
 	}
+	inv.Columns[2] = inv.basisXform(t.Columns[2].Mulf(-1))
+	return inv
+}
+
+// Invert inverts the transformation in place, assuming a pure rotation. See Inverse.
+func (t *Transform2D) Invert() {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Transform2D.Invert", *t)
+	}
+	*t = t.Inverse()
 }
 
 // AffineInverse computes the matrix inverse handling potential scalings.
+// A singular transformation returns the zero Transform2D; use AffineInvert to
+// detect that case.
 func (t Transform2D) AffineInverse() Transform2D {
 	det := t.determinant()
 	if det == 0 {
@@ -145,20 +190,62 @@ func (t Transform2D) AffineInverse() Transform2D {
 	}
 	idet := 1.0 / det
 
-	return Transform2D{
+	inv := Transform2D{
 		Columns: [3]vector2.Vector2{
 			vector2.New(t.Columns[1].Y*idet, -t.Columns[0].Y*idet),
 			vector2.New(-t.Columns[1].X*idet, t.Columns[0].X*idet),
-			vector2.New(-t.tdotx(t.Columns[2])*idet, -t.tdoty(t.Columns[2])*idet),
 		},
 	}
+	inv.Columns[2] = inv.basisXform(t.Columns[2].Mulf(-1))
+	return inv
+}
+
+// AffineInvert inverts the transformation in place, handling potential scalings.
+// It returns ErrSingular and leaves the transformation unchanged if the determinant is zero.
+func (t *Transform2D) AffineInvert() error {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Transform2D.AffineInvert", *t)
+	}
+	if t.determinant() == 0 {
+		return zerogdscript.ErrSingular
+	}
+	*t = t.AffineInverse()
+	return nil
 }
 
 // Xform applies the transformation to a vector.
 func (t Transform2D) Xform(vec vector2.Vector2) vector2.Vector2 {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Transform2D.Xform", t, vec)
+	}
 	return vector2.New(t.tdotx(vec), t.tdoty(vec)).Add(t.Columns[2])
 }
 
+// Validate returns a *zerogdscript.ValidationError naming the first column
+// component that is NaN or infinite, or wrapping ErrZeroLength for a zero-size
+// basis column.
+func (t Transform2D) Validate() error {
+	for i, c := range t.Columns {
+		if err := zerogdscript.ValidateFinite("Transform2D", fmt.Sprintf("Columns[%d].X", i), c.X); err != nil {
+			return err
+		}
+		if err := zerogdscript.ValidateFinite("Transform2D", fmt.Sprintf("Columns[%d].Y", i), c.Y); err != nil {
+			return err
+		}
+	}
+	for i := 0; i < 2; i++ {
+		if t.Columns[i].LengthSquared() == 0 {
+			return &zerogdscript.ValidationError{Type: "Transform2D", Field: fmt.Sprintf("Columns[%d]", i), Err: zerogdscript.ErrZeroLength}
+		}
+	}
+	return nil
+}
+
+// basisXform applies only the basis of the transformation to a vector.
+func (t Transform2D) basisXform(v vector2.Vector2) vector2.Vector2 {
+	return vector2.New(t.tdotx(v), t.tdoty(v))
+}
+
 // tdotx calculates the dot product with the x-axis of the transformation.
 func (t Transform2D) tdotx(v vector2.Vector2) float64 {
 	return t.Columns[0].X*v.X + t.Columns[1].X*v.Y
@@ -173,3 +260,26 @@ func (t Transform2D) tdoty(v vector2.Vector2) float64 {
 func (t Transform2D) determinant() float64 {
 	return t.Columns[0].X*t.Columns[1].Y - t.Columns[1].X*t.Columns[0].Y
 }
+
+// AreaScale returns the factor by which the transform scales areas, the
+// absolute determinant of its linear part. A mirror flips orientation but
+// still reports a positive factor; the origin plays no part.
+func (t Transform2D) AreaScale() float64 {
+	return math.Abs(t.determinant())
+}
+
+// AsArray returns the columns in order, as Godot lays them out in memory:
+// [X.X, X.Y, Y.X, Y.Y, Origin.X, Origin.Y].
+func (t Transform2D) AsArray() [6]float64 {
+	c := t.Columns
+	return [6]float64{c[0].X, c[0].Y, c[1].X, c[1].Y, c[2].X, c[2].Y}
+}
+
+// FromArray is the inverse of AsArray.
+func FromArray(a [6]float64) Transform2D {
+	return Transform2D{Columns: [3]vector2.Vector2{
+		vector2.New(a[0], a[1]),
+		vector2.New(a[2], a[3]),
+		vector2.New(a[4], a[5]),
+	}}
+}
@@ -1,8 +1,25 @@
 package transform2d
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"testing"
 
-func TestTransform2D_NewTransform2D(t *testing.T) {}
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+func TestTransform2D_NewTransform2D(t *testing.T) {
+	for _, r := range []float64{0, 0.6, -1.2, 3} {
+		tr := NewTransform2D(r, vector2.New(3, -1))
+		if got := tr.GetRotation(); !zerogdscript.IsEqualApprox(got, r) {
+			t.Errorf("NewTransform2D(%v).GetRotation() = %v", r, got)
+		}
+	}
+	if got := NewTransform2D(zerogdscript.PI/2, vector2.New(3, -1)).Xform(vector2.New(1, 0)); !got.IsEqualApprox(vector2.New(3, 0)) {
+		t.Errorf("Xform() of a quarter turn = %v, want (3, 0)", got)
+	}
+}
 
 func TestTransform2D_Transform2DFromCells(t *testing.T) {}
 
@@ -12,9 +29,24 @@ func TestTransform2D_ToLocal(t *testing.T) {}
 
 func TestTransform2D_ToGlobal(t *testing.T) {}
 
-func TestTransform2D_Inverse(t *testing.T) {}
+func TestTransform2D_Inverse(t *testing.T) {
+	tr := NewTransform2D(0.6, vector2.New(3, -1))
+	p := vector2.New(2, 5)
+	if got := tr.Inverse().Xform(tr.Xform(p)); !got.IsEqualApprox(p) {
+		t.Errorf("Inverse().Xform(Xform(p)) = %v, want %v", got, p)
+	}
+}
 
-func TestTransform2D_AffineInverse(t *testing.T) {}
+func TestTransform2D_AffineInverse(t *testing.T) {
+	tr := Transform2DFromCells(2, 0.5, -1, 3, 4, 5)
+	p := vector2.New(2, 5)
+	if got := tr.AffineInverse().Xform(tr.Xform(p)); !got.IsEqualApprox(p) {
+		t.Errorf("AffineInverse().Xform(Xform(p)) = %v, want %v", got, p)
+	}
+	if got := tr.ToLocal(tr.ToGlobal(p)); !got.IsEqualApprox(p) {
+		t.Errorf("ToLocal(ToGlobal(p)) = %v, want %v", got, p)
+	}
+}
 
 func TestTransform2D_Xform(t *testing.T) {}
 
@@ -23,3 +55,147 @@ func TestTransform2D_tdotx(t *testing.T) {}
 func TestTransform2D_tdoty(t *testing.T) {}
 
 func TestTransform2D_determinant(t *testing.T) {}
+
+func TestTransform2D_AreaScale(t *testing.T) {
+	for _, tt := range []struct {
+		name string
+		t    Transform2D
+		want float64
+	}{
+		{"identity", Transform2DFromCells(1, 0, 0, 1, 0, 0), 1},
+		{"uniform scale 2", Transform2DFromCells(2, 0, 0, 2, 5, -3), 4},
+		{"rotation", NewTransform2D(0.7, vector2.New(3, 4)), 1},
+		{"mirror", Transform2DFromCells(-1, 0, 0, 1, 0, 0), 1},
+		{"scaled mirror", Transform2DFromCells(0, 3, 2, 0, 0, 0), 6},
+		{"shear", Transform2DFromCells(1, 0, 4, 1, 0, 0), 1},
+		{"collapsed", Transform2DFromCells(1, 2, 2, 4, 0, 0), 0},
+	} {
+		if got := tt.t.AreaScale(); !zerogdscript.IsEqualApprox(got, tt.want) {
+			t.Errorf("%s: AreaScale() = %v, want %v", tt.name, got, tt.want)
+		}
+	}
+
+	// A unit square maps to a parallelogram of area AreaScale.
+	tr := Transform2DFromCells(1.5, 0.5, -0.25, 2, 7, 7)
+	o, x, y := tr.Xform(vector2.Zero()), tr.Xform(vector2.New(1, 0)), tr.Xform(vector2.New(0, 1))
+	if area := math.Abs(x.Sub(o).Cross(y.Sub(o))); !zerogdscript.IsEqualApprox(area, tr.AreaScale()) {
+		t.Errorf("unit square maps to area %v, want AreaScale() = %v", area, tr.AreaScale())
+	}
+}
+
+func TestTransform2D_InvalidInput(t *testing.T) {
+	singular := Transform2DFromCells(1, 2, 2, 4, 5, 6)
+	rigid := NewTransform2D(0.6, vector2.New(3, -1))
+
+	tests := []struct {
+		name    string
+		checked func() (Transform2D, error)
+		want    Transform2D
+		wantErr error
+	}{
+		{"AffineInvert singular", func() (Transform2D, error) { tr := singular; err := tr.AffineInvert(); return tr, err }, singular, zerogdscript.ErrSingular},
+		{"AffineInverse singular", func() (Transform2D, error) { return singular.AffineInverse(), nil }, Transform2D{}, nil},
+		{"AffineInvert rigid", func() (Transform2D, error) { tr := rigid; err := tr.AffineInvert(); return tr, err }, rigid.Inverse(), nil},
+		{"Invert rigid", func() (Transform2D, error) { tr := rigid; tr.Invert(); return tr, nil }, rigid.AffineInverse(), nil},
+	}
+	for _, tt := range tests {
+		got, err := tt.checked()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		for i := range got.Columns {
+			if !got.Columns[i].IsEqualApprox(tt.want.Columns[i]) {
+				t.Errorf("%s: got %v, want %v", tt.name, got.Columns, tt.want.Columns)
+				break
+			}
+		}
+	}
+}
+
+func TestTransform2D_Validate(t *testing.T) {
+	if err := NewTransform2D(0.5, vector2.New(1, 2)).Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+
+	nan := NewTransform2D(0, vector2.Zero())
+	nan.Columns[2].Y = math.NaN()
+	flat := NewTransform2D(0, vector2.Zero())
+	flat.Columns[1] = vector2.Zero()
+	for _, tt := range []struct {
+		t       Transform2D
+		want    string
+		wantErr error
+	}{
+		{nan, "Transform2D.Columns[2].Y: value is not finite: NaN", zerogdscript.ErrNonFinite},
+		{flat, "Transform2D.Columns[1]: vector has zero length", zerogdscript.ErrZeroLength},
+	} {
+		err := tt.t.Validate()
+		if err == nil || err.Error() != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v.Validate() = %v, want %q", tt.t, err, tt.want)
+		}
+	}
+}
+
+func TestTransform2D_Debug(t *testing.T) {
+	var reports []string
+	zerogdscript.SetDebug(true, func(err error) { reports = append(reports, err.Error()) })
+	defer zerogdscript.SetDebug(false, nil)
+
+	tr := NewTransform2D(0, vector2.Zero())
+	tr.Xform(vector2.New(math.NaN(), 0))
+	tr.Columns[0] = vector2.Zero()
+	tr.AffineInvert()
+
+	want := []string{
+		"Transform2D.Xform: Vector2.X: value is not finite: NaN",
+		"Transform2D.AffineInvert: Transform2D.Columns[0]: vector has zero length",
+	}
+	if len(reports) != len(want) || reports[0] != want[0] || reports[1] != want[1] {
+		t.Errorf("reports = %q, want %q", reports, want)
+	}
+}
+
+func TestTransform2D_AsArray(t *testing.T) {
+	tr := Transform2D{Columns: [3]vector2.Vector2{vector2.New(1, 2), vector2.New(3, 4), vector2.New(5, 6)}}
+	if got, want := tr.AsArray(), [6]float64{1, 2, 3, 4, 5, 6}; got != want {
+		t.Errorf("AsArray() = %v, want %v", got, want)
+	}
+	if got := FromArray(tr.AsArray()); got != tr {
+		t.Errorf("FromArray(AsArray()) = %v, want %v", got, tr)
+	}
+}
+
+func TestTransform2D_InterpolateWithEasing(t *testing.T) {
+	from := NewTransform2D(0, vector2.New(0, 0))
+	to := NewTransform2D(math.Pi/2, vector2.New(100, 0))
+	to.SetScale(vector2.New(2, 2))
+
+	fast := func(w float64) float64 { return math.Sqrt(w) }
+	slow := func(w float64) float64 { return w * w }
+
+	for _, w := range []float64{0.1, 0.25, 0.5, 0.9} {
+		got := from.InterpolateWithEasing(to, w, fast, slow)
+		if want := 100 * fast(w); math.Abs(got.Columns[2].X-want) > 1e-9 {
+			t.Errorf("weight %v: origin X = %v, want %v from the position ease", w, got.Columns[2].X, want)
+		}
+		if want := math.Pi / 2 * slow(w); math.Abs(got.GetRotation()-want) > 1e-9 {
+			t.Errorf("weight %v: rotation = %v, want %v from the rotation ease", w, got.GetRotation(), want)
+		}
+		if want := 1 + slow(w); math.Abs(got.GetScale().X-want) > 1e-9 {
+			t.Errorf("weight %v: scale = %v, want %v from the rotation ease", w, got.GetScale().X, want)
+		}
+	}
+
+	if got := from.InterpolateWithEasing(to, 0, fast, slow); got != from {
+		t.Errorf("weight 0 = %v, want exactly %v", got, from)
+	}
+	if got := from.InterpolateWithEasing(to, 1, fast, slow); got != to {
+		t.Errorf("weight 1 = %v, want exactly %v", got, to)
+	}
+
+	// Without eases both components move linearly.
+	mid := from.InterpolateWith(to, 0.5)
+	if math.Abs(mid.Columns[2].X-50) > 1e-9 || math.Abs(mid.GetRotation()-math.Pi/4) > 1e-9 {
+		t.Errorf("InterpolateWith(0.5) = %v, want origin 50 and rotation Pi/4", mid)
+	}
+}
@@ -1 +1,297 @@
 package transform3d
+
+/**************************************************************************/
+/*  transform_3d.h                                                        */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/aabb"
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
+	"github.com/Anaxarchus/zero-gdscript/pkg/projection"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+// Transform3D represents a 3D transformation: a Basis for rotation, scale and
+// shear, and an Origin for translation.
+type Transform3D struct {
+	Basis  basis.Basis
+	Origin vector3.Vector3
+}
+
+// New creates a Transform3D from a basis and an origin.
+func New(b basis.Basis, origin vector3.Vector3) Transform3D {
+	return Transform3D{Basis: b, Origin: origin}
+}
+
+// Identity returns the transform with an identity basis and a zero origin.
+func Identity() Transform3D {
+	return New(basis.New(), vector3.Zero())
+}
+
+// Xform applies the transformation to a point.
+func (t Transform3D) Xform(v vector3.Vector3) vector3.Vector3 {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Transform3D.Xform", t, v)
+	}
+	return basisXform(t.Basis, v).Add(t.Origin)
+}
+
+// XformInv applies the inverse of the transformation to a point, assuming the
+// basis is orthonormal. It is the cheaper equivalent of Inverse().Xform(v).
+func (t Transform3D) XformInv(v vector3.Vector3) vector3.Vector3 {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Transform3D.XformInv", t, v)
+	}
+	return basisXform(t.Basis.Transposed(), v.Sub(t.Origin))
+}
+
+// XformInvAll applies XformInv to every point and returns the results in a
+// new slice, for moving a batch of points into the transform's local space.
+func (t Transform3D) XformInvAll(points []vector3.Vector3) []vector3.Vector3 {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Transform3D.XformInvAll", t)
+	}
+	inv := t.Basis.Transposed()
+	res := make([]vector3.Vector3, len(points))
+	for i, p := range points {
+		res[i] = basisXform(inv, p.Sub(t.Origin))
+	}
+	return res
+}
+
+// XformAABB returns the axis-aligned box that encloses box after the
+// transformation, like Godot's Transform3D.xform(AABB). Rotation makes the
+// result larger than the transformed box itself.
+func (t Transform3D) XformAABB(box aabb.AABB) aabb.AABB {
+	min, max := box.Position.AsArray(), box.GetEnd().AsArray()
+	tmin, tmax := t.Origin.AsArray(), t.Origin.AsArray()
+	for i := 0; i < 3; i++ {
+		for j := 0; j < 3; j++ {
+			e := t.Basis.Rows[i][j] * min[j]
+			f := t.Basis.Rows[i][j] * max[j]
+			if e < f {
+				tmin[i] += e
+				tmax[i] += f
+			} else {
+				tmin[i] += f
+				tmax[i] += e
+			}
+		}
+	}
+	return aabb.New(vector3.FromArray(tmin), vector3.FromArray(tmax).Sub(vector3.FromArray(tmin)))
+}
+
+// CullAABBs reports for each box whether it may be visible in frustum. The
+// transform maps the boxes' space into the frustum's space, typically the
+// inverse camera transform times the object's transform. Each box is moved
+// with XformAABB and tested with Frustum.IntersectsAABB, so the test is
+// conservative: a box may be reported visible when it is just outside.
+func (t Transform3D) CullAABBs(boxes []aabb.AABB, frustum projection.Frustum) []bool {
+	visible := make([]bool, len(boxes))
+	for i, box := range boxes {
+		visible[i] = frustum.IntersectsAABB(t.XformAABB(box))
+	}
+	return visible
+}
+
+// Mul returns the composition t * m, which applies m first and then t:
+// t.Mul(m).Xform(v) == t.Xform(m.Xform(v)).
+func (t Transform3D) Mul(m Transform3D) Transform3D {
+	return New(t.Basis.Mul(m.Basis), basisXform(t.Basis, m.Origin).Add(t.Origin))
+}
+
+// Translated returns the transform translated by offset in the parent
+// (global) frame. Equivalent to left multiplication by a translation.
+func (t Transform3D) Translated(offset vector3.Vector3) Transform3D {
+	return New(t.Basis, t.Origin.Add(offset))
+}
+
+// Rotated returns the transform rotated around axis by angle in radians in
+// the parent frame, so the origin is rotated too. Equivalent to left
+// multiplication by a rotation. A zero axis leaves the transform unchanged.
+func (t Transform3D) Rotated(axis vector3.Vector3, angle float64) Transform3D {
+	return New(basis.FromAxisAndAngle(axis.AsArray(), angle), vector3.Zero()).Mul(t)
+}
+
+// Scaled returns the transform scaled by scale in the parent frame, so the
+// origin is scaled too. Equivalent to left multiplication by a scale.
+func (t Transform3D) Scaled(scale vector3.Vector3) Transform3D {
+	var s basis.Basis
+	s.Set(scale.X, 0, 0, 0, scale.Y, 0, 0, 0, scale.Z)
+	return New(s, vector3.Zero()).Mul(t)
+}
+
+// LookingAt returns the transform rotated so that its -Z axis points from the
+// origin toward target and its +Y axis is as close to up as possible, like
+// Godot's Transform3D.looking_at. The origin is kept and any scale is
+// dropped. If up is zero or parallel to the view direction, an arbitrary
+// axis perpendicular to the direction is used as X instead. A target at the
+// origin leaves the transform unchanged.
+func (t Transform3D) LookingAt(target, up vector3.Vector3) Transform3D {
+	dir := target.Sub(t.Origin)
+	if dir.LengthSquared() == 0 {
+		return t
+	}
+	z := dir.Normalized().Mulf(-1)
+	x := up.Cross(z)
+	if zerogdscript.IsZeroApprox(x.X) && zerogdscript.IsZeroApprox(x.Y) && zerogdscript.IsZeroApprox(x.Z) {
+		x, _ = z.TangentBasis()
+	}
+	x = x.Normalized()
+	y := z.Cross(x)
+
+	var b basis.Basis
+	b.SetColumns(x.AsArray(), y.AsArray(), z.AsArray())
+	return New(b, t.Origin)
+}
+
+// LookAt returns a transform placed at eye whose -Z axis points toward
+// target, with +Y as close to up as possible. See LookingAt.
+func LookAt(eye, target, up vector3.Vector3) Transform3D {
+	return New(basis.New(), eye).LookingAt(target, up)
+}
+
+// Inverse returns the inverse of the transformation, assuming the basis is
+// orthonormal (rotation only). It transposes the basis, which is much cheaper
+// than AffineInverse but wrong under scale or shear.
+func (t Transform3D) Inverse() Transform3D {
+	b := t.Basis.Transposed()
+	return New(b, basisXform(b, t.Origin.Mulf(-1)))
+}
+
+// Invert inverts the transformation in place, assuming an orthonormal basis. See Inverse.
+func (t *Transform3D) Invert() {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Transform3D.Invert", *t)
+	}
+	*t = t.Inverse()
+}
+
+// AffineInverse returns the inverse of the transformation, handling scale and shear.
+// A singular basis returns the zero Transform3D; use AffineInvert to detect that case.
+func (t Transform3D) AffineInverse() Transform3D {
+	b := t.Basis
+	if err := b.Invert(); err != nil {
+		return Transform3D{}
+	}
+	return New(b, basisXform(b, t.Origin.Mulf(-1)))
+}
+
+// AffineInvert inverts the transformation in place, handling scale and shear.
+// It returns ErrSingular and leaves the transformation unchanged if the basis cannot be inverted.
+func (t *Transform3D) AffineInvert() error {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Transform3D.AffineInvert", *t)
+	}
+	if t.Basis.Determinant() == 0 {
+		return zerogdscript.ErrSingular
+	}
+	*t = t.AffineInverse()
+	return nil
+}
+
+// Validate returns a *zerogdscript.ValidationError naming the first field of
+// the basis or origin that fails validation. See basis.Basis.Validate.
+func (t Transform3D) Validate() error {
+	if err := t.Basis.Validate(); err != nil {
+		return prefixField(err, "Basis.")
+	}
+	if err := t.Origin.Validate(); err != nil {
+		return prefixField(err, "Origin.")
+	}
+	return nil
+}
+
+// prefixField renames the field of a nested validation error to its path
+// within the Transform3D.
+func prefixField(err error, prefix string) error {
+	ve, ok := err.(*zerogdscript.ValidationError)
+	if !ok {
+		return err
+	}
+	return &zerogdscript.ValidationError{Type: "Transform3D", Field: prefix + ve.Field, Err: ve.Err}
+}
+
+// basisXform applies only the basis to a vector.
+func basisXform(b basis.Basis, v vector3.Vector3) vector3.Vector3 {
+	// Not b.Xform, so debug mode reports a bad basis once, as Transform3D.
+	return vector3.New(
+		b.Rows[0][0]*v.X+b.Rows[0][1]*v.Y+b.Rows[0][2]*v.Z,
+		b.Rows[1][0]*v.X+b.Rows[1][1]*v.Y+b.Rows[1][2]*v.Z,
+		b.Rows[2][0]*v.X+b.Rows[2][1]*v.Y+b.Rows[2][2]*v.Z,
+	)
+}
+
+// AsArray returns the basis row by row followed by the origin, as Godot lays
+// out a Transform3D in memory: the first nine elements are
+// Basis.AsArrayRowMajor and the last three are Origin.X, Origin.Y, Origin.Z.
+func (t Transform3D) AsArray() [12]float64 {
+	var a [12]float64
+	b := t.Basis.AsArrayRowMajor()
+	copy(a[:9], b[:])
+	a[9], a[10], a[11] = t.Origin.X, t.Origin.Y, t.Origin.Z
+	return a
+}
+
+// FromArray is the inverse of AsArray.
+func FromArray(a [12]float64) Transform3D {
+	var b [9]float64
+	copy(b[:], a[:9])
+	return New(basis.FromArrayRowMajor(b), vector3.New(a[9], a[10], a[11]))
+}
+
+// ToFloat32Slice returns the twelve elements in the AsArray order, the basis
+// row by row followed by the origin, converted to float32 for GPU buffers
+// and FFI.
+func (t Transform3D) ToFloat32Slice() []float32 {
+	a := t.AsArray()
+	s := make([]float32, len(a))
+	for i, x := range a {
+		s[i] = float32(x)
+	}
+	return s
+}
+
+// FromFloat32Slice is the inverse of ToFloat32Slice. It returns
+// ErrInvalidLength and the identity unless s has exactly twelve elements.
+func FromFloat32Slice(s []float32) (Transform3D, error) {
+	var a [12]float64
+	if len(s) != len(a) {
+		return Identity(), zerogdscript.ErrInvalidLength
+	}
+	for i, x := range s {
+		a[i] = float64(x)
+	}
+	return FromArray(a), nil
+}
@@ -1 +1,331 @@
 package transform3d
+
+import (
+	"errors"
+	"math"
+	"reflect"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/aabb"
+	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
+	"github.com/Anaxarchus/zero-gdscript/pkg/projection"
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector3"
+)
+
+func TestTransform3D_Xform(t *testing.T) {
+	tr := New(basis.FromAxisAndAngle([3]float64{0, 0, 1}, math.Pi/2), vector3.New(1, 2, 3))
+	if got, want := tr.Xform(vector3.New(1, 0, 0)), vector3.New(1, 3, 3); !got.IsEqualApprox(want) {
+		t.Errorf("Xform() = %v, want %v", got, want)
+	}
+	if got, p := Identity().Xform(vector3.New(4, 5, 6)), vector3.New(4, 5, 6); !got.IsEqualApprox(p) {
+		t.Errorf("Identity().Xform() = %v, want %v", got, p)
+	}
+}
+
+func TestTransform3D_Inverse(t *testing.T) {
+	rigid := New(basis.FromAxisAndAngle([3]float64{1, 2, 3}, 0.7), vector3.New(4, -5, 6))
+	p := vector3.New(0.5, -2, 3)
+
+	inv := rigid.Inverse()
+	affine := rigid.AffineInverse()
+	if got := inv.Xform(rigid.Xform(p)); !got.IsEqualApprox(p) {
+		t.Errorf("Inverse().Xform(Xform(p)) = %v, want %v", got, p)
+	}
+	if got, want := inv.Xform(p), affine.Xform(p); !got.IsEqualApprox(want) {
+		t.Errorf("Inverse() and AffineInverse() disagree on a rigid transform: %v vs %v", got, want)
+	}
+}
+
+func TestTransform3D_XformInv(t *testing.T) {
+	tr := New(basis.FromAxisAndAngle([3]float64{1, -1, 2}, 1.3), vector3.New(4, -5, 6))
+	for _, p := range []vector3.Vector3{vector3.Zero(), vector3.New(1, 2, 3), vector3.New(-7, 0.5, 2)} {
+		if got := tr.XformInv(tr.Xform(p)); !got.IsEqualApprox(p) {
+			t.Errorf("XformInv(Xform(%v)) = %v", p, got)
+		}
+		if got, want := tr.XformInv(p), tr.Inverse().Xform(p); !got.IsEqualApprox(want) {
+			t.Errorf("XformInv(%v) = %v, Inverse().Xform gives %v", p, got, want)
+		}
+	}
+}
+
+func TestTransform3D_Compose(t *testing.T) {
+	z := vector3.New(0, 0, 1)
+	// Rotate a quarter turn around Z, then move by (10, 0, 0).
+	tr := Identity().Rotated(z, math.Pi/2).Translated(vector3.New(10, 0, 0))
+	if got, want := tr.Xform(vector3.New(1, 0, 0)), vector3.New(10, 1, 0); !got.IsEqualApprox(want) {
+		t.Errorf("rotate then translate: Xform(1, 0, 0) = %v, want %v", got, want)
+	}
+	// Rotated acts in the parent frame, so it also swings the origin.
+	tr = Identity().Translated(vector3.New(10, 0, 0)).Rotated(z, math.Pi/2)
+	if got, want := tr.Xform(vector3.New(1, 0, 0)), vector3.New(0, 11, 0); !got.IsEqualApprox(want) {
+		t.Errorf("translate then rotate: Xform(1, 0, 0) = %v, want %v", got, want)
+	}
+	if got, want := tr.Origin, vector3.New(0, 10, 0); !got.IsEqualApprox(want) {
+		t.Errorf("translate then rotate: Origin = %v, want %v", got, want)
+	}
+
+	scaled := Identity().Translated(vector3.New(1, 1, 1)).Scaled(vector3.New(2, 3, 4))
+	if got, want := scaled.Xform(vector3.New(1, 1, 1)), vector3.New(4, 6, 8); !got.IsEqualApprox(want) {
+		t.Errorf("Scaled: Xform(1, 1, 1) = %v, want %v", got, want)
+	}
+
+	a := Identity().Rotated(vector3.New(1, 2, 3), 0.7).Translated(vector3.New(4, -5, 6))
+	b := Identity().Rotated(vector3.New(0, 1, 0), -1.2).Translated(vector3.New(-1, 0.5, 2))
+	ab := a.Mul(b)
+	for _, p := range []vector3.Vector3{vector3.Zero(), vector3.New(1, 2, 3), vector3.New(-7, 0.5, 2)} {
+		if got, want := ab.Xform(p), a.Xform(b.Xform(p)); !got.IsEqualApprox(want) {
+			t.Errorf("a.Mul(b).Xform(%v) = %v, want %v", p, got, want)
+		}
+		if got := ab.Inverse().Xform(ab.Xform(p)); !got.IsEqualApprox(p) {
+			t.Errorf("Inverse().Xform(Xform(%v)) = %v", p, got)
+		}
+		if got := ab.XformInv(ab.Xform(p)); !got.IsEqualApprox(p) {
+			t.Errorf("XformInv(Xform(%v)) = %v", p, got)
+		}
+	}
+	if got := a.Mul(Identity()); got != a {
+		t.Errorf("a.Mul(Identity()) = %v, want %v", got, a)
+	}
+}
+
+func TestTransform3D_LookingAt(t *testing.T) {
+	up := vector3.New(0, 1, 0)
+	column := func(tr Transform3D, i int) vector3.Vector3 {
+		return vector3.New(tr.Basis.Rows[0][i], tr.Basis.Rows[1][i], tr.Basis.Rows[2][i])
+	}
+	for _, tt := range []struct {
+		eye, target vector3.Vector3
+	}{
+		{vector3.New(0, 0, 0), vector3.New(0, 0, -5)},
+		{vector3.New(1, 2, 3), vector3.New(-4, 0.5, 7)},
+		{vector3.New(0, 10, 0), vector3.New(3, 0, 1)},
+	} {
+		tr := LookAt(tt.eye, tt.target, up)
+		forward := column(tr, 2).Mulf(-1)
+		if want := tt.target.Sub(tt.eye).Normalized(); !forward.IsEqualApprox(want) {
+			t.Errorf("LookAt(%v, %v): forward = %v, want %v", tt.eye, tt.target, forward, want)
+		}
+		if !tr.Origin.IsEqualApprox(tt.eye) {
+			t.Errorf("LookAt(%v, %v): origin = %v, want the eye", tt.eye, tt.target, tr.Origin)
+		}
+		// The target lies straight ahead on the local -Z axis.
+		local := tr.XformInv(tt.target)
+		if !zerogdscript.IsZeroApprox(local.X) || !zerogdscript.IsZeroApprox(local.Y) || local.Z >= 0 {
+			t.Errorf("LookAt(%v, %v): target in local space = %v, want on -Z", tt.eye, tt.target, local)
+		}
+		// The up hint is respected: X stays horizontal and Y points up.
+		if x := column(tr, 0); !zerogdscript.IsZeroApprox(x.Y) {
+			t.Errorf("LookAt(%v, %v): X axis %v is not horizontal", tt.eye, tt.target, x)
+		}
+		if y := column(tr, 1); y.Y <= 0 {
+			t.Errorf("LookAt(%v, %v): Y axis %v does not point up", tt.eye, tt.target, y)
+		}
+		if det := tr.Basis.Determinant(); !zerogdscript.IsEqualApprox(det, 1) {
+			t.Errorf("LookAt(%v, %v): determinant = %v, want 1", tt.eye, tt.target, det)
+		}
+	}
+
+	// Looking straight along up still gives a valid rotation.
+	tr := LookAt(vector3.Zero(), vector3.New(0, 5, 0), up)
+	if forward := column(tr, 2).Mulf(-1); !forward.IsEqualApprox(up) {
+		t.Errorf("LookAt(straight up): forward = %v, want %v", forward, up)
+	}
+	if det := tr.Basis.Determinant(); !zerogdscript.IsEqualApprox(det, 1) {
+		t.Errorf("LookAt(straight up): determinant = %v, want 1", det)
+	}
+
+	// LookingAt keeps the origin and discards the old rotation and scale.
+	start := Identity().Scaled(vector3.New(2, 2, 2)).Rotated(vector3.New(1, 0, 0), 0.5).Translated(vector3.New(1, 1, 1))
+	if got, want := start.LookingAt(vector3.New(5, 1, 1), up), LookAt(start.Origin, vector3.New(5, 1, 1), up); got != want {
+		t.Errorf("LookingAt = %v, want %v", got, want)
+	}
+	if got := start.LookingAt(start.Origin, up); got != start {
+		t.Errorf("LookingAt(origin) = %v, want the transform unchanged", got)
+	}
+}
+
+func TestTransform3D_AffineInverse(t *testing.T) {
+	b := basis.FromAxisAndAngle([3]float64{0, 1, 0}, 0.4)
+	for i := 0; i < 3; i++ {
+		b.Rows[i][0] *= 2
+		b.Rows[i][2] *= 0.5
+	}
+	scaled := New(b, vector3.New(1, 2, 3))
+	p := vector3.New(0.5, -2, 3)
+
+	if got := scaled.AffineInverse().Xform(scaled.Xform(p)); !got.IsEqualApprox(p) {
+		t.Errorf("AffineInverse().Xform(Xform(p)) = %v, want %v", got, p)
+	}
+	if got := scaled.Inverse().Xform(scaled.Xform(p)); got.IsEqualApprox(p) {
+		t.Errorf("Inverse() round-tripped a scaled transform, expected the fast path to be wrong under scale")
+	}
+}
+
+func TestTransform3D_AffineInvert(t *testing.T) {
+	var flat basis.Basis
+	flat.Set(1, 0, 0, 0, 1, 0, 0, 0, 0)
+	singular := New(flat, vector3.New(1, 1, 1))
+	if err := singular.AffineInvert(); !errors.Is(err, zerogdscript.ErrSingular) {
+		t.Errorf("AffineInvert() of a singular transform = %v, want ErrSingular", err)
+	}
+	if got := singular.AffineInverse(); got != (Transform3D{}) {
+		t.Errorf("AffineInverse() of a singular transform = %v, want the zero transform", got)
+	}
+
+	tr := New(basis.FromAxisAndAngle([3]float64{0, 0, 1}, 1), vector3.New(3, 0, 0))
+	want := tr.Inverse()
+	tr.Invert()
+	if tr != want {
+		t.Errorf("Invert() = %v, want %v", tr, want)
+	}
+}
+
+func TestTransform3D_Validate(t *testing.T) {
+	if err := Identity().Validate(); err != nil {
+		t.Errorf("Identity().Validate() = %v, want nil", err)
+	}
+
+	nanBasis := Identity()
+	nanBasis.Basis.Rows[2][1] = math.NaN()
+	nanOrigin := Identity()
+	nanOrigin.Origin.Z = math.Inf(1)
+	singular := New(basis.Basis{}, vector3.Zero())
+	for _, tt := range []struct {
+		t       Transform3D
+		want    string
+		wantErr error
+	}{
+		{nanBasis, "Transform3D.Basis.Rows[2][1]: value is not finite: NaN", zerogdscript.ErrNonFinite},
+		{nanOrigin, "Transform3D.Origin.Z: value is not finite: +Inf", zerogdscript.ErrNonFinite},
+		{singular, "Transform3D.Basis.Determinant: matrix is not invertible, determinant is zero", zerogdscript.ErrSingular},
+	} {
+		err := tt.t.Validate()
+		if err == nil || err.Error() != tt.want || !errors.Is(err, tt.wantErr) {
+			t.Errorf("%v.Validate() = %v, want %q", tt.t, err, tt.want)
+		}
+	}
+}
+
+func TestTransform3D_Debug(t *testing.T) {
+	var reports []string
+	zerogdscript.SetDebug(true, func(err error) { reports = append(reports, err.Error()) })
+	defer zerogdscript.SetDebug(false, nil)
+
+	tr := Identity()
+	tr.Origin.X = math.NaN()
+	tr.Xform(vector3.New(1, 2, 3))
+	tr.Invert()
+
+	want := []string{
+		"Transform3D.Xform: Transform3D.Origin.X: value is not finite: NaN",
+		"Transform3D.Invert: Transform3D.Origin.X: value is not finite: NaN",
+	}
+	if len(reports) != len(want) || reports[0] != want[0] || reports[1] != want[1] {
+		t.Errorf("reports = %q, want %q", reports, want)
+	}
+}
+
+func TestTransform3D_AsArray(t *testing.T) {
+	tr := New(basis.Basis{Rows: [3][3]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}}, vector3.New(10, 11, 12))
+	if got, want := tr.AsArray(), [12]float64{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}; got != want {
+		t.Errorf("AsArray() = %v, want %v", got, want)
+	}
+	if got := FromArray(tr.AsArray()); got != tr {
+		t.Errorf("FromArray(AsArray()) = %v, want %v", got, tr)
+	}
+}
+
+func TestTransform3D_Float32Slice(t *testing.T) {
+	tr := New(basis.Basis{Rows: [3][3]float64{{1, 2, 3}, {4, 5, 6}, {7, 8, 9}}}, vector3.New(10, 11, 12))
+	s := tr.ToFloat32Slice()
+	if want := []float32{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}; !reflect.DeepEqual(s, want) {
+		t.Errorf("ToFloat32Slice() = %v, want %v", s, want)
+	}
+	if got, err := FromFloat32Slice(s); err != nil || got != tr {
+		t.Errorf("FromFloat32Slice(ToFloat32Slice()) = %v, %v, want %v", got, err, tr)
+	}
+
+	// Precision loss is relative: float32 keeps about seven significant digits.
+	tr = New(basis.FromEuler([3]float64{0.3, -1.1, 2.2}, zerogdscript.EulerOrderYXZ), vector3.New(1234.5678, -0.001, 98765.4321))
+	got, err := FromFloat32Slice(tr.ToFloat32Slice())
+	if err != nil {
+		t.Fatalf("FromFloat32Slice: %v", err)
+	}
+	want := tr.AsArray()
+	for i, x := range got.AsArray() {
+		if d := math.Abs(x - want[i]); d > 1e-7*math.Max(1, math.Abs(want[i])) {
+			t.Errorf("element %d round trip off by %v", i, d)
+		}
+	}
+
+	for _, s := range [][]float32{nil, make([]float32, 9), make([]float32, 16)} {
+		if got, err := FromFloat32Slice(s); !errors.Is(err, zerogdscript.ErrInvalidLength) || got != Identity() {
+			t.Errorf("FromFloat32Slice(len %d) = %v, %v, want the identity and ErrInvalidLength", len(s), got, err)
+		}
+	}
+}
+
+func TestTransform3D_XformAABB(t *testing.T) {
+	box := aabb.New(vector3.New(-1, -2, -3), vector3.New(2, 4, 6))
+	tr := Identity().Rotated(vector3.New(0, 1, 0), math.Pi/2).Translated(vector3.New(10, 0, 0))
+	got := tr.XformAABB(box)
+	// A quarter turn about Y swaps the X and Z extents.
+	want := aabb.New(vector3.New(7, -2, -1), vector3.New(6, 4, 2))
+	if !got.Position.IsEqualApprox(want.Position) || !got.Size.IsEqualApprox(want.Size) {
+		t.Errorf("XformAABB() = %v, want %v", got, want)
+	}
+	// Every transformed corner is inside the result.
+	for i := 0; i < 8; i++ {
+		p := tr.Xform(box.GetEndpoint(i))
+		lo, hi := got.Position, got.GetEnd()
+		if p.X < lo.X-1e-9 || p.Y < lo.Y-1e-9 || p.Z < lo.Z-1e-9 || p.X > hi.X+1e-9 || p.Y > hi.Y+1e-9 || p.Z > hi.Z+1e-9 {
+			t.Errorf("corner %d maps to %v, outside %v", i, p, got)
+		}
+	}
+}
+
+func TestTransform3D_XformInvAll(t *testing.T) {
+	tr := New(basis.FromAxisAndAngle([3]float64{1, -1, 2}, 1.3), vector3.New(4, -5, 6))
+	points := []vector3.Vector3{vector3.Zero(), vector3.New(1, 2, 3), vector3.New(-7, 0.5, 2)}
+	got := tr.XformInvAll(points)
+	if len(got) != len(points) {
+		t.Fatalf("XformInvAll returned %d points, want %d", len(got), len(points))
+	}
+	for i, p := range points {
+		if want := tr.XformInv(p); !got[i].IsEqualApprox(want) {
+			t.Errorf("XformInvAll()[%d] = %v, want %v", i, got[i], want)
+		}
+	}
+}
+
+func TestTransform3D_CullAABBs(t *testing.T) {
+	frustum := projection.CreatePerspective(90, 1, 1, 100).GetProjectionPlanes()
+	// A camera at (0, 0, 20) looking down -Z; world-to-view is its inverse.
+	view := LookAt(vector3.New(0, 0, 20), vector3.Zero(), vector3.New(0, 1, 0)).Inverse()
+	unit := vector3.New(1, 1, 1)
+	boxes := []aabb.AABB{
+		aabb.New(vector3.New(-0.5, -0.5, -0.5), unit), // straight ahead
+		aabb.New(vector3.New(-0.5, -0.5, 30), unit),   // behind the camera
+		aabb.New(vector3.New(40, -0.5, -0.5), unit),   // far off to the right
+		aabb.New(vector3.New(19.5, -0.5, -0.5), unit), // straddles the right plane
+		aabb.New(vector3.New(-0.5, -0.5, 18.6), unit), // straddles the near plane
+		aabb.New(vector3.New(-0.5, -0.5, -200), unit), // beyond the far plane
+	}
+	want := []bool{true, false, false, true, true, false}
+	got := view.CullAABBs(boxes, frustum)
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("CullAABBs() = %v, want %v", got, want)
+	}
+
+	// Rotating the boxes' space by a half turn about Y brings the box behind
+	// the camera in front of it; the centred box stays and the far-right
+	// one moves far to the left.
+	turned := view.Mul(Identity().Rotated(vector3.New(0, 1, 0), math.Pi))
+	if got := turned.CullAABBs(boxes[:3], frustum); !reflect.DeepEqual(got, []bool{true, true, false}) {
+		t.Errorf("CullAABBs(turned) = %v, want [true true false]", got)
+	}
+	if got := view.CullAABBs(nil, frustum); len(got) != 0 {
+		t.Errorf("CullAABBs(nil) = %v, want empty", got)
+	}
+}
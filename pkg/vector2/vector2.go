@@ -75,18 +75,10 @@ func (v Vector2) Mul(b Vector2) Vector2 {
 	return v
 }
 
+// Div divides v by b component-wise. Division by zero follows IEEE-754.
 func (v Vector2) Div(b Vector2) Vector2 {
-	if b.X == 0 {
-		v.X = math.Inf(1)
-	} else {
-		v.X /= b.X
-	}
-
-	if b.Y == 0 {
-		v.Y = math.Inf(1)
-	} else {
-		v.Y /= b.Y
-	}
+	v.X /= b.X
+	v.Y /= b.Y
 	return v
 }
 
@@ -108,17 +100,43 @@ func (v Vector2) Mulf(s float64) Vector2 {
 	return v
 }
 
+// Divf divides v by s. Division by zero follows IEEE-754.
 func (v Vector2) Divf(s float64) Vector2 {
-	if s == 0 {
-		v.X = math.Inf(1)
-		v.Y = math.Inf(1)
-	} else {
-		v.X /= s
-		v.Y /= s
-	}
+	v.X /= s
+	v.Y /= s
 	return v
 }
 
+// AddAssign adds b to v in place.
+func (v *Vector2) AddAssign(b Vector2) {
+	v.X += b.X
+	v.Y += b.Y
+}
+
+// SubAssign subtracts b from v in place.
+func (v *Vector2) SubAssign(b Vector2) {
+	v.X -= b.X
+	v.Y -= b.Y
+}
+
+// MulfAssign scales v by s in place.
+func (v *Vector2) MulfAssign(s float64) {
+	v.X *= s
+	v.Y *= s
+}
+
+func (v Vector2) Lerp(to Vector2, weight float64) Vector2 {
+	v.X = zerogdscript.Lerp(v.X, to.X, weight)
+	v.Y = zerogdscript.Lerp(v.Y, to.Y, weight)
+	return v
+}
+
+// LerpAssign interpolates v towards to by weight in place.
+func (v *Vector2) LerpAssign(to Vector2, weight float64) {
+	v.X = zerogdscript.Lerp(v.X, to.X, weight)
+	v.Y = zerogdscript.Lerp(v.Y, to.Y, weight)
+}
+
 func (v Vector2) Angle() float64 {
 	return math.Atan2(v.Y, v.X)
 }
@@ -175,6 +193,30 @@ func (v Vector2) AngleTo(b Vector2) float64 {
 	return math.Atan2(v.Cross(b), v.Dot(b))
 }
 
+// Validate returns a *zerogdscript.ValidationError naming the first
+// component that is NaN or infinite.
+func (v Vector2) Validate() error {
+	if err := zerogdscript.ValidateFinite("Vector2", "X", v.X); err != nil {
+		return err
+	}
+	return zerogdscript.ValidateFinite("Vector2", "Y", v.Y)
+}
+
+// IsWithinCone reports whether v points within halfAngle radians of forward,
+// boundary included. It compares dot products instead of calling acos, so it
+// is cheap enough for per-frame vision checks. A zero v or forward is never
+// within the cone.
+func (v Vector2) IsWithinCone(forward Vector2, halfAngle float64) bool {
+	l2 := v.LengthSquared() * forward.LengthSquared()
+	if l2 == 0 {
+		return false
+	}
+	// Compare signed squared cosines, which order the same way as the cosines.
+	d := v.Dot(forward)
+	c := math.Cos(halfAngle)
+	return d*math.Abs(d)/l2 >= c*math.Abs(c)-zerogdscript.CMP_EPSILON2
+}
+
 func (v Vector2) AngleToPoint(b Vector2) float64 {
 	return b.Sub(v).Angle()
 }
@@ -183,10 +225,76 @@ func (v Vector2) Dot(b Vector2) float64 {
 	return v.X*b.X + v.Y*b.Y
 }
 
+// BezierInterpolate returns the point at position t on the cubic Bezier curve
+// from v to end with the given control points.
+func (v Vector2) BezierInterpolate(control1, control2, end Vector2, t float64) Vector2 {
+	v.X = zerogdscript.BezierInterpolate(v.X, control1.X, control2.X, end.X, t)
+	v.Y = zerogdscript.BezierInterpolate(v.Y, control1.Y, control2.Y, end.Y, t)
+	return v
+}
+
+// BezierLength returns the arc length of the cubic Bezier curve from v to end.
+// See zerogdscript.ArcLength for subdivisions.
+func (v Vector2) BezierLength(control1, control2, end Vector2, subdivisions int) float64 {
+	return zerogdscript.ArcLength(func(t float64) float64 {
+		return v.BezierDerivative(control1, control2, end, t).Length()
+	}, 0, 1, subdivisions)
+}
+
+// BezierSampleByDistance returns the position t on the cubic Bezier curve from
+// v to end at which the arc length from v reaches distance, to within
+// CMP_EPSILON. Pass the result to BezierInterpolate to get the point. Use
+// zerogdscript.ArcLengthParameter directly for another tolerance.
+func (v Vector2) BezierSampleByDistance(control1, control2, end Vector2, distance float64) float64 {
+	return zerogdscript.ArcLengthParameter(func(t float64) float64 {
+		return v.BezierDerivative(control1, control2, end, t).Length()
+	}, distance, zerogdscript.CMP_EPSILON)
+}
+
+// BezierDerivative returns the first derivative of the cubic Bezier curve from
+// v to end with the given control points at position t.
+func (v Vector2) BezierDerivative(control1, control2, end Vector2, t float64) Vector2 {
+	v.X = zerogdscript.BezierDerivative(v.X, control1.X, control2.X, end.X, t)
+	v.Y = zerogdscript.BezierDerivative(v.Y, control1.Y, control2.Y, end.Y, t)
+	return v
+}
+
+// BezierSecondDerivative returns the second derivative of the cubic Bezier
+// curve from v to end with the given control points at position t.
+func (v Vector2) BezierSecondDerivative(control1, control2, end Vector2, t float64) Vector2 {
+	v.X = zerogdscript.BezierSecondDerivative(v.X, control1.X, control2.X, end.X, t)
+	v.Y = zerogdscript.BezierSecondDerivative(v.Y, control1.Y, control2.Y, end.Y, t)
+	return v
+}
+
+// BezierCurvature returns the signed curvature of the cubic Bezier curve from
+// v to end at position t, the inverse of the radius of the osculating circle.
+// It is positive where the curve turns counter-clockwise (towards +Y from +X).
+// Where the first derivative vanishes, such as at a cusp or a control point
+// that coincides with its end point, the curvature is undefined and 0 is
+// returned. The test is relative to the length of the control polygon, so
+// curves of any scale are handled alike.
+func (v Vector2) BezierCurvature(control1, control2, end Vector2, t float64) float64 {
+	d1 := v.BezierDerivative(control1, control2, end, t)
+	d2 := v.BezierSecondDerivative(control1, control2, end, t)
+	lsq := d1.LengthSquared()
+	size := v.DistanceTo(control1) + control1.DistanceTo(control2) + control2.DistanceTo(end)
+	if tol := zerogdscript.CMP_EPSILON * size; lsq <= tol*tol {
+		return 0
+	}
+	return d1.Cross(d2) / (lsq * math.Sqrt(lsq))
+}
+
 func (v Vector2) Cross(b Vector2) float64 {
 	return v.X*b.Y - v.Y*b.X
 }
 
+func (v Vector2) Abs() Vector2 {
+	v.X = math.Abs(v.X)
+	v.Y = math.Abs(v.Y)
+	return v
+}
+
 func (v Vector2) Sign() Vector2 {
 	v.X = zerogdscript.Sign(v.X)
 	v.Y = zerogdscript.Sign(v.Y)
@@ -214,9 +322,7 @@ func (v Vector2) Round() Vector2 {
 func (v Vector2) Rotated(x float64) Vector2 {
 	sine := math.Sin(x)
 	cosi := math.Cos(x)
-	v.X = v.X*cosi - v.Y*sine
-	v.Y = v.X*sine + v.Y*cosi
-	return v
+	return New(v.X*cosi-v.Y*sine, v.X*sine+v.Y*cosi)
 }
 
 func (v Vector2) Posmod(x float64) Vector2 {
@@ -269,6 +375,51 @@ func (v Vector2) LimitLength(maxLength float64) Vector2 {
 	return res
 }
 
+// Spring advances a damped spring pulling v toward target by dt seconds and
+// returns the new position and velocity, per axis as zerogdscript.Spring.
+func (v Vector2) Spring(target, velocity Vector2, stiffness, damping, dt float64) (Vector2, Vector2) {
+	v.X, velocity.X = zerogdscript.Spring(v.X, target.X, velocity.X, stiffness, damping, dt)
+	v.Y, velocity.Y = zerogdscript.Spring(v.Y, target.Y, velocity.Y, stiffness, damping, dt)
+	return v, velocity
+}
+
+// SmoothDamp moves v toward target like a critically damped spring, as
+// zerogdscript.SmoothDamp but along the straight line to target, so maxSpeed
+// caps the length of the velocity rather than each axis. velocity is updated
+// in place. The result never passes target and lands exactly on it, with the
+// velocity zeroed, once within CMP_EPSILON. A smoothTime below 0.0001 moves
+// straight to target without smoothing.
+func (v Vector2) SmoothDamp(target Vector2, velocity *Vector2, smoothTime, maxSpeed, delta float64) Vector2 {
+	if delta <= 0 {
+		return v
+	}
+	if smoothTime < 0.0001 {
+		step := target.Sub(v)
+		if l := step.Length(); l > maxSpeed*delta {
+			step = step.Mulf(maxSpeed * delta / l)
+			*velocity = step.Divf(delta)
+			return v.Add(step)
+		}
+		*velocity = Zero()
+		return target
+	}
+	omega := 2.0 / smoothTime
+	x := omega * delta
+	decay := 1.0 / (1.0 + x + 0.48*x*x + 0.235*x*x*x)
+	change := v.Sub(target)
+	if l, maxChange := change.Length(), maxSpeed*smoothTime; l > maxChange {
+		change = change.Mulf(maxChange / l)
+	}
+	temp := velocity.Add(change.Mulf(omega)).Mulf(delta)
+	*velocity = velocity.Sub(temp.Mulf(omega)).Mulf(decay)
+	output := v.Sub(change).Add(change.Add(temp).Mulf(decay))
+	if target.Sub(v).Dot(output.Sub(target)) > 0 || output.DistanceTo(target) <= zerogdscript.CMP_EPSILON {
+		*velocity = Zero()
+		return target
+	}
+	return output
+}
+
 func (v Vector2) MoveToward(to Vector2, delta float64) Vector2 {
 	vd := to.Sub(v)
 	len := vd.Length()
@@ -278,24 +429,90 @@ func (v Vector2) MoveToward(to Vector2, delta float64) Vector2 {
 	return vd.Divf(len).Mulf(delta).Add(v)
 }
 
-// slide returns the component of the vector along the given plane, specified by its normal vector.
+// Slide returns the component of the vector along the given plane, specified by its normal vector.
+// A non-normalized normal is normalized first; a zero normal returns v unchanged.
 func (v Vector2) Slide(normal Vector2) Vector2 {
-	if !normal.IsNormalized() {
-		panic("normal:Vector2 must be normalized before function:Vector2.Slide")
+	res, _ := v.SlideChecked(normal)
+	return res
+}
+
+// SlideChecked is like Slide but returns ErrZeroLength for a zero normal.
+func (v Vector2) SlideChecked(normal Vector2) (Vector2, error) {
+	normal, err := unitNormal(normal)
+	if err != nil {
+		return v, err
 	}
-	return v.Sub(normal.Mulf(v.Dot(normal)))
+	return v.Sub(normal.Mulf(v.Dot(normal))), nil
 }
 
-func (v Vector2) Bound(b Vector2) Vector2 {
-	return v.Reflect(b).Mulf(-1)
+// Bounce returns the vector "bounced off" from a line defined by the given normal.
+// A non-normalized normal is normalized first; a zero normal returns v unchanged.
+func (v Vector2) Bounce(normal Vector2) Vector2 {
+	res, _ := v.BounceChecked(normal)
+	return res
+}
+
+// BounceChecked is like Bounce but returns ErrZeroLength for a zero normal.
+func (v Vector2) BounceChecked(normal Vector2) (Vector2, error) {
+	res, err := v.ReflectChecked(normal)
+	if err != nil {
+		return v, err
+	}
+	return res.Mulf(-1), nil
 }
 
+// Bound is the old name of Bounce.
+//
+// Deprecated: use Bounce.
+func (v Vector2) Bound(b Vector2) Vector2 {
+	return v.Bounce(b)
+}
+
+// Reflect returns the vector reflected from a line defined by the given normal.
+// A non-normalized normal is normalized first; a zero normal returns v unchanged.
+//
+// The mirror line runs along normal, not along the surface: the component of
+// v parallel to normal is kept and the perpendicular component is flipped,
+// that is 2*normal*dot(v, normal) - v. This matches Godot's Vector2.reflect
+// but is the negation of GLSL's reflect. To bounce a velocity off a surface
+// whose normal is n, use Bounce, which is -Reflect.
+//
+//	        n
+//	        ^
+//	  r \   |   / v
+//	     \  |  /
+//	      \ | /
+//	-------\|/------- surface
+//	        |\
+//	        | \ Bounce(n)
+//
+// Here v = (1, 1) and n = (0, 1): Reflect gives r = (-1, 1) and Bounce gives
+// (1, -1).
 func (v Vector2) Reflect(normal Vector2) Vector2 {
-	if !normal.IsNormalized() {
-		panic("normal:Vector2 must be normalized before function:Vector2.Slide")
+	res, _ := v.ReflectChecked(normal)
+	return res
+}
+
+// ReflectChecked is like Reflect but returns ErrZeroLength for a zero normal.
+func (v Vector2) ReflectChecked(normal Vector2) (Vector2, error) {
+	normal, err := unitNormal(normal)
+	if err != nil {
+		return v, err
 	}
 	//return 2.0f * p_normal * dot(p_normal) - *this;
-	return normal.Mulf(2.0).Mulf(v.Dot(normal)).Sub(v)
+	return normal.Mulf(2.0).Mulf(v.Dot(normal)).Sub(v), nil
+}
+
+// unitNormal returns normal with unit length, leaving it untouched when it is
+// already normalized within tolerance.
+func unitNormal(normal Vector2) (Vector2, error) {
+	if normal.IsNormalized() {
+		return normal, nil
+	}
+	if normal.LengthSquared() == 0 {
+		return normal, zerogdscript.ErrZeroLength
+	}
+	return normal.Normalized(), nil
 }
 
 func (v Vector2) IsEqual(b Vector2) bool {
@@ -303,13 +520,101 @@ func (v Vector2) IsEqual(b Vector2) bool {
 }
 
 func (v Vector2) IsEqualApprox(b Vector2) bool {
-	return zerogdscript.IsEqualApprox(v.X, b.X) && zerogdscript.IsEqualApprox(v.Y, b.Y)
+	return v.IsEqualApproxTol(b, zerogdscript.CMP_EPSILON)
+}
+
+// IsEqualApproxTol reports whether each component of v differs from b by less
+// than tolerance. Use it with a tolerance that fits the scale of the data, for
+// example when comparing large coordinates.
+func (v Vector2) IsEqualApproxTol(b Vector2, tolerance float64) bool {
+	return zerogdscript.IsEqualApproxTol(v.X, b.X, tolerance) && zerogdscript.IsEqualApproxTol(v.Y, b.Y, tolerance)
 }
 
 func (v Vector2) IsZeroApprox() bool {
 	return zerogdscript.IsZeroApprox(v.X) && zerogdscript.IsZeroApprox(v.Y)
 }
 
+// IsFinite reports whether neither component is NaN or an infinity of
+// either sign.
 func (v Vector2) IsFinite() bool {
-	return !math.IsInf(v.X, 1) && !math.IsInf(v.Y, 1)
+	return zerogdscript.IsFinitef(v.X) && zerogdscript.IsFinitef(v.Y)
+}
+
+// AbsAll returns a new slice holding the absolute value of each vector in s.
+func AbsAll(s []Vector2) []Vector2 {
+	res := make([]Vector2, len(s))
+	for i, v := range s {
+		res[i] = v.Abs()
+	}
+	return res
+}
+
+// SignAll returns a new slice holding the sign of each vector in s.
+func SignAll(s []Vector2) []Vector2 {
+	res := make([]Vector2, len(s))
+	for i, v := range s {
+		res[i] = v.Sign()
+	}
+	return res
+}
+
+// AsArray returns the components as [X, Y].
+func (v Vector2) AsArray() [2]float64 {
+	return [2]float64{v.X, v.Y}
+}
+
+// FromArray returns the vector with components [X, Y].
+func FromArray(a [2]float64) Vector2 {
+	return Vector2{X: a[0], Y: a[1]}
+}
+
+// CatmullRom evaluates the cardinal spline segment from p1 to p2 at t in [0, 1]
+// with the given tension, per component. See zerogdscript.CatmullRom; tension
+// 0.5 is the uniform Catmull-Rom spline.
+func CatmullRom(p0, p1, p2, p3 Vector2, t, tension float64) Vector2 {
+	return Vector2{
+		X: zerogdscript.CatmullRom(p0.X, p1.X, p2.X, p3.X, t, tension),
+		Y: zerogdscript.CatmullRom(p0.Y, p1.Y, p2.Y, p3.Y, t, tension),
+	}
+}
+
+// CatmullRomCentripetal evaluates the centripetal Catmull-Rom segment from p1
+// to p2 at t in [0, 1]. The knots are spaced by the square root of the
+// distance between consecutive points, so unevenly spaced points do not
+// produce the cusps and self-intersecting loops of the uniform spline.
+func CatmullRomCentripetal(p0, p1, p2, p3 Vector2, t float64) Vector2 {
+	pre, to, post := zerogdscript.CentripetalKnots(p0.DistanceTo(p1), p1.DistanceTo(p2), p2.DistanceTo(p3))
+	return Vector2{
+		X: zerogdscript.CubicInterpolateInTime(p1.X, p2.X, p0.X, p3.X, t, to, pre, post),
+		Y: zerogdscript.CubicInterpolateInTime(p1.Y, p2.Y, p0.Y, p3.Y, t, to, pre, post),
+	}
+}
+
+// Step returns 0 for each component of v below the matching edge component
+// and 1 otherwise, like GLSL's step.
+func Step(edge, v Vector2) Vector2 {
+	return Vector2{
+		X: zerogdscript.Step(edge.X, v.X),
+		Y: zerogdscript.Step(edge.Y, v.Y),
+	}
+}
+
+// Smoothstep returns the Hermite interpolation 3t^2 - 2t^3 of each component
+// of v between the matching components of edgeLow and edgeHigh, like GLSL's
+// smoothstep. Unlike zerogdscript.Smoothstep, which follows Godot, equal
+// edges give Step(edgeLow, v) for that component instead of the edge value.
+func Smoothstep(edgeLow, edgeHigh, v Vector2) Vector2 {
+	return Vector2{
+		X: smoothstep(edgeLow.X, edgeHigh.X, v.X),
+		Y: smoothstep(edgeLow.Y, edgeHigh.Y, v.Y),
+	}
+}
+
+// smoothstep is GLSL's smoothstep for one component. See Smoothstep.
+func smoothstep(edgeLow, edgeHigh, x float64) float64 {
+	if edgeLow == edgeHigh {
+		return zerogdscript.Step(edgeLow, x)
+	}
+	t := zerogdscript.Clampf((x-edgeLow)/(edgeHigh-edgeLow), 0, 1)
+	return t * t * (3 - 2*t)
 }
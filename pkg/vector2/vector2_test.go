@@ -1,6 +1,12 @@
 package vector2
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+)
 
 func TestVector2_Add(t *testing.T) {}
 
@@ -46,6 +52,12 @@ func TestVector2_Dot(t *testing.T) {}
 
 func TestVector2_Cross(t *testing.T) {}
 
+func TestVector2_Abs(t *testing.T) {
+	if got := New(-1.5, 2).Abs(); !got.IsEqual(New(1.5, 2)) {
+		t.Errorf("Abs() = %v, want (1.5, 2)", got)
+	}
+}
+
 func TestVector2_Sign(t *testing.T) {}
 
 func TestVector2_Floor(t *testing.T) {}
@@ -54,7 +66,14 @@ func TestVector2_Ceil(t *testing.T) {}
 
 func TestVector2_Round(t *testing.T) {}
 
-func TestVector2_Rotated(t *testing.T) {}
+func TestVector2_Rotated(t *testing.T) {
+	if got := New(1, 0).Rotated(math.Pi / 2); !got.IsEqualApprox(New(0, 1)) {
+		t.Errorf("Rotated(pi/2) = %v, want (0, 1)", got)
+	}
+	if got := New(2, 1).Rotated(math.Pi); !got.IsEqualApprox(New(-2, -1)) {
+		t.Errorf("Rotated(pi) = %v, want (-2, -1)", got)
+	}
+}
 
 func TestVector2_Posmod(t *testing.T) {}
 
@@ -74,16 +93,510 @@ func TestVector2_LimitLength(t *testing.T) {}
 
 func TestVector2_MoveToward(t *testing.T) {}
 
+func TestVector2_SmoothDamp(t *testing.T) {
+	const dt = 1.0 / 60
+	pos, vel := New(0, 5), Zero()
+	target := New(3, -1)
+	dist := pos.DistanceTo(target)
+	for i := 0; i < 300; i++ {
+		pos = pos.SmoothDamp(target, &vel, 0.3, math.Inf(1), dt)
+		d := pos.DistanceTo(target)
+		if d > dist {
+			t.Fatalf("frame %d: moved away from the target, %v > %v", i, d, dist)
+		}
+		dist = d
+	}
+	if pos != target || vel != Zero() {
+		t.Errorf("ended at %v moving %v, want exactly %v at rest", pos, vel, target)
+	}
+
+	// maxSpeed caps the length of the velocity, not each axis.
+	pos, vel = New(0, 5), Zero()
+	for i := 0; i < 60; i++ {
+		prev := pos
+		pos = pos.SmoothDamp(target, &vel, 0.3, 2, dt)
+		if speed := pos.DistanceTo(prev) / dt; speed > 2+1e-9 || vel.Length() > 2+1e-9 {
+			t.Fatalf("frame %d: moving at %v (velocity %v), want at most 2", i, speed, vel)
+		}
+	}
+
+	pos, vel = New(0, 5), Zero()
+	if pos = pos.SmoothDamp(target, &vel, 0, math.Inf(1), dt); pos != target || vel != Zero() {
+		t.Errorf("zero smoothTime gave %v moving %v, want %v at rest", pos, vel, target)
+	}
+}
+
+func TestVector2_Spring(t *testing.T) {
+	pos, vel := New(0, 5), Zero()
+	target := New(3, -1)
+	for i := 0; i < 600; i++ {
+		pos, vel = pos.Spring(target, vel, 100, 20, 1.0/120)
+	}
+	if !pos.IsEqualApprox(target) {
+		t.Errorf("spring settled at %v, want %v", pos, target)
+	}
+	x, vx := zerogdscript.Spring(0, 3, 0, 100, 20, 0.01)
+	if p, v := Zero().Spring(New(3, 0), Zero(), 100, 20, 0.01); p.X != x || v.X != vx || p.Y != 0 || v.Y != 0 {
+		t.Errorf("Spring = %v, %v, want the scalar result (%v, %v) on X only", p, v, x, vx)
+	}
+}
+
 func TestVector2_Slide(t *testing.T) {}
 
 func TestVector2_Bound(t *testing.T) {}
 
-func TestVector2_Reflect(t *testing.T) {}
+func TestVector2_Reflect(t *testing.T) {
+	for _, tt := range []struct {
+		name            string
+		v, normal       Vector2
+		reflect, bounce Vector2
+	}{
+		// A ball falling onto a floor keeps moving right and goes back up.
+		{"floor", New(1, -1), New(0, 1), New(-1, -1), New(1, 1)},
+		// The same floor with an unnormalized normal.
+		{"floor scaled", New(1, -1), New(0, 5), New(-1, -1), New(1, 1)},
+		// A ball moving right hits a wall sloping up at 45 degrees and leaves
+		// straight up.
+		{"45 degree wall", New(1, 0), New(-1, 1), New(0, -1), New(0, 1)},
+		// Head-on into the 45 degree wall it comes straight back.
+		{"45 degree head-on", New(1, -1), New(-1, 1), New(1, -1), New(-1, 1)},
+	} {
+		if got := tt.v.Reflect(tt.normal); !got.IsEqualApprox(tt.reflect) {
+			t.Errorf("%s: Reflect = %v, want %v", tt.name, got, tt.reflect)
+		}
+		if got := tt.v.Bounce(tt.normal); !got.IsEqualApprox(tt.bounce) {
+			t.Errorf("%s: Bounce = %v, want %v", tt.name, got, tt.bounce)
+		}
+	}
+
+	// Reflect keeps the length and the component along the normal.
+	v, n := New(3, -4), New(1, 2).Normalized()
+	r := v.Reflect(n)
+	if !zerogdscript.IsEqualApprox(r.Length(), v.Length()) || !zerogdscript.IsEqualApprox(r.Dot(n), v.Dot(n)) {
+		t.Errorf("Reflect(%v, %v) = %v does not mirror about the normal", v, n, r)
+	}
+}
 
 func TestVector2_IsEqual(t *testing.T) {}
 
 func TestVector2_IsEqualApprox(t *testing.T) {}
 
+func TestVector2_BezierCurvature(t *testing.T) {
+	// The standard cubic approximation of a quarter circle of radius r,
+	// counter-clockwise from (r, 0) to (0, r). Its curvature stays within
+	// 3% of 1/r, at any scale.
+	const k = 0.5522847498
+	for _, r := range []float64{1, 4, 0.001, 1e-6, 1e6} {
+		start, c1, c2, end := New(r, 0), New(r, r*k), New(r*k, r), New(0, r)
+		for _, w := range []float64{0, 0.3, 0.5, 1} {
+			if got := start.BezierCurvature(c1, c2, end, w); math.Abs(got-1/r) > 0.03/r {
+				t.Errorf("quarter circle r=%v: BezierCurvature(%v) = %v, want about %v", r, w, got, 1/r)
+			}
+			// Traversed clockwise, the sign flips.
+			if got := end.BezierCurvature(c2, c1, start, w); math.Abs(got+1/r) > 0.03/r {
+				t.Errorf("reversed quarter circle r=%v: BezierCurvature(%v) = %v, want about %v", r, w, got, -1/r)
+			}
+		}
+		// At the start it is exactly 2/3 * |(c1-start) x (c2-c1)| / |c1-start|^3.
+		if got, want := start.BezierCurvature(c1, c2, end, 0), 2.0/3.0*(1-k)/(k*k*r); !zerogdscript.IsEqualApprox(got, want) {
+			t.Errorf("quarter circle r=%v: BezierCurvature(0) = %v, want %v", r, got, want)
+		}
+	}
+
+	// A straight segment has zero curvature.
+	if got := New(0, 0).BezierCurvature(New(1, 1), New(2, 2), New(3, 3), 0.4); !zerogdscript.IsZeroApprox(got) {
+		t.Errorf("BezierCurvature(line) = %v, want 0", got)
+	}
+
+	// Degenerate: a control point on the start point makes the first
+	// derivative vanish at t=0, and a fully collapsed curve everywhere.
+	if got := New(0, 0).BezierCurvature(New(0, 0), New(1, 1), New(2, 0), 0); got != 0 {
+		t.Errorf("BezierCurvature with a vanishing derivative = %v, want 0", got)
+	}
+	p := New(2, 3)
+	for _, w := range []float64{0, 0.5, 1} {
+		if got := p.BezierCurvature(p, p, p, w); got != 0 {
+			t.Errorf("BezierCurvature(point, %v) = %v, want 0", w, got)
+		}
+	}
+
+	// The second derivative matches the scalar version per component.
+	start, c1, c2, end := New(0, 1), New(2, 5), New(4, -1), New(6, 2)
+	got := start.BezierSecondDerivative(c1, c2, end, 0.3)
+	want := New(zerogdscript.BezierSecondDerivative(0, 2, 4, 6, 0.3), zerogdscript.BezierSecondDerivative(1, 5, -1, 2, 0.3))
+	if !got.IsEqualApprox(want) {
+		t.Errorf("BezierSecondDerivative = %v, want %v", got, want)
+	}
+}
+
+func TestVector2_BezierLength(t *testing.T) {
+	// A straight segment with evenly spaced control points has its chord length.
+	if got := New(0, 0).BezierLength(New(1, 1), New(2, 2), New(3, 3), 1); !zerogdscript.IsEqualApprox(got, 3*math.Sqrt2) {
+		t.Errorf("BezierLength(line) = %v, want %v", got, 3*math.Sqrt2)
+	}
+	// The quarter-circle approximation is within 0.03% of PI/2 * r.
+	const k = 0.5522847498
+	got := New(2, 0).BezierLength(New(2, 2*k), New(2*k, 2), New(0, 2), 8)
+	if math.Abs(got-math.Pi) > 3e-4*math.Pi {
+		t.Errorf("BezierLength(quarter circle r=2) = %v, want about %v", got, math.Pi)
+	}
+	// More subdivisions converge.
+	start, c1, c2, end := New(0, 0), New(10, 0), New(-5, 5), New(5, 5)
+	coarse, fine, finer := start.BezierLength(c1, c2, end, 2), start.BezierLength(c1, c2, end, 64), start.BezierLength(c1, c2, end, 256)
+	if math.Abs(finer-fine) > 1e-6 || math.Abs(finer-fine) > math.Abs(finer-coarse) {
+		t.Errorf("BezierLength does not converge: %v, %v, %v", coarse, fine, finer)
+	}
+}
+
+func TestVector2_BezierSampleByDistance(t *testing.T) {
+	// An S-shaped toolpath whose parameter speed varies a lot.
+	start, c1, c2, end := New(0, 0), New(10, 0), New(-5, 5), New(5, 5)
+	total := start.BezierLength(c1, c2, end, 256)
+
+	speed := func(t float64) float64 { return start.BezierDerivative(c1, c2, end, t).Length() }
+
+	const n = 40
+	step := total / n
+	prev, prevT := start, 0.0
+	for i := 1; i <= n; i++ {
+		tt := start.BezierSampleByDistance(c1, c2, end, step*float64(i))
+		p := start.BezierInterpolate(c1, c2, end, tt)
+		// The arc between neighbours is one step. The chord is never longer
+		// and only slightly shorter where the path bends.
+		if arc := zerogdscript.ArcLength(speed, prevT, tt, 16); math.Abs(arc-step) > 1e-6 {
+			t.Errorf("step %d: arc length %v, want %v", i, arc, step)
+		}
+		if chord := p.DistanceTo(prev); chord > step+1e-6 || chord < step*0.95 {
+			t.Errorf("step %d: chord %v, want at most %v", i, chord, step)
+		}
+		prev, prevT = p, tt
+	}
+	if !prev.IsEqualApprox(end) {
+		t.Errorf("walk ended at %v, want %v", prev, end)
+	}
+	if got := start.BezierSampleByDistance(c1, c2, end, 0); got != 0 {
+		t.Errorf("BezierSampleByDistance(0) = %v, want 0", got)
+	}
+}
+
+func TestVector2_Step(t *testing.T) {
+	edge := New(0.5, -1)
+	for _, tt := range []struct {
+		v, want Vector2
+	}{
+		{New(0.4, -2), New(0, 0)},
+		{New(0.5, -1), New(1, 1)},
+		{New(0.6, -1.5), New(1, 0)},
+	} {
+		if got := Step(edge, tt.v); got != tt.want {
+			t.Errorf("Step(%v, %v) = %v, want %v", edge, tt.v, got, tt.want)
+		}
+	}
+}
+
+func TestVector2_Smoothstep(t *testing.T) {
+	low, high := New(0, 2), New(1, 4)
+	for _, tt := range []struct {
+		v, want Vector2
+	}{
+		// Below, at and above the edges.
+		{New(-1, 1), New(0, 0)},
+		{New(0, 2), New(0, 0)},
+		{New(1, 4), New(1, 1)},
+		{New(2, 9), New(1, 1)},
+		// GLSL smoothstep(0, 1, 0.25) = 0.15625 and smoothstep(2, 4, 3) = 0.5.
+		{New(0.25, 3), New(0.15625, 0.5)},
+		{New(0.75, 3.5), New(0.84375, 0.84375)},
+	} {
+		if got := Smoothstep(low, high, tt.v); !got.IsEqualApprox(tt.want) {
+			t.Errorf("Smoothstep(%v, %v, %v) = %v, want %v", low, high, tt.v, got, tt.want)
+		}
+	}
+	// Equal edges behave like Step.
+	if got, want := Smoothstep(New(1, 1), New(1, 1), New(0.9, 1)), New(0, 1); got != want {
+		t.Errorf("Smoothstep with equal edges = %v, want %v", got, want)
+	}
+}
+
+func TestVector2_CatmullRom(t *testing.T) {
+	p0, p1, p2, p3 := New(-1, 2), New(0, 0), New(3, 1), New(4, 4)
+	for _, w := range []float64{0, 0.4, 1} {
+		got := CatmullRom(p0, p1, p2, p3, w, 0.3)
+		want := New(zerogdscript.CatmullRom(p0.X, p1.X, p2.X, p3.X, w, 0.3), zerogdscript.CatmullRom(p0.Y, p1.Y, p2.Y, p3.Y, w, 0.3))
+		if !got.IsEqualApprox(want) {
+			t.Errorf("CatmullRom(%v) = %v, want %v", w, got, want)
+		}
+	}
+
+	// A short segment after a long one: the uniform spline loops back past
+	// p2, while the centripetal one moves steadily towards it.
+	p0, p1, p2, p3 = New(-10, 0), New(0, 0), New(1, 0.1), New(1, 1.1)
+	loops := false
+	for w := 0.05; w < 1; w += 0.05 {
+		if CatmullRom(p0, p1, p2, p3, w, 0.5).X > p2.X {
+			loops = true
+		}
+		if got := CatmullRomCentripetal(p0, p1, p2, p3, w); got.X > p2.X+1e-12 || got.X < p1.X {
+			t.Errorf("CatmullRomCentripetal(%v) = %v, want X within [%v, %v]", w, got, p1.X, p2.X)
+		}
+	}
+	if !loops {
+		t.Errorf("uniform CatmullRom stayed within the segment; the test points no longer exercise the difference")
+	}
+	if got := CatmullRomCentripetal(p0, p1, p2, p3, 0); !got.IsEqualApprox(p1) {
+		t.Errorf("CatmullRomCentripetal(0) = %v, want %v", got, p1)
+	}
+	if got := CatmullRomCentripetal(p0, p1, p2, p3, 1); !got.IsEqualApprox(p2) {
+		t.Errorf("CatmullRomCentripetal(1) = %v, want %v", got, p2)
+	}
+}
+
+func TestVector2_IsEqualApproxTol(t *testing.T) {
+	a := New(1_250_000, -830_000)
+	if !a.IsEqualApproxTol(New(1_250_000.4, -830_000.3), 0.5) {
+		t.Errorf("IsEqualApproxTol within 0.5 = false, want true")
+	}
+	if a.IsEqualApproxTol(New(1_250_000.4, -830_000.7), 0.5) {
+		t.Errorf("IsEqualApproxTol with Y off by 0.7 = true, want false")
+	}
+	if a.IsEqualApprox(New(1_250_000.4, -830_000.3)) {
+		t.Errorf("IsEqualApprox with the default tolerance = true, want false")
+	}
+}
+
 func TestVector2_IsZeroApprox(t *testing.T) {}
 
-func TestVector2_IsFinite(t *testing.T) {}
+func TestVector2_IsFinite(t *testing.T) {
+	if !New(1.5, -1e300).IsFinite() {
+		t.Errorf("IsFinite() = false for a finite vector")
+	}
+	for _, bad := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		if v := New(bad, 0); v.IsFinite() {
+			t.Errorf("%v.IsFinite() = true, want false", v)
+		}
+		if v := New(0, bad); v.IsFinite() {
+			t.Errorf("%v.IsFinite() = true, want false", v)
+		}
+	}
+}
+
+func TestVector2_AbsAll(t *testing.T) {
+	in := []Vector2{New(-1, 2), New(0, -3.5), New(4, 0)}
+	want := []Vector2{New(1, 2), New(0, 3.5), New(4, 0)}
+	got := AbsAll(in)
+	if len(got) != len(want) {
+		t.Fatalf("AbsAll() returned %d vectors, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].IsEqual(want[i]) {
+			t.Errorf("AbsAll()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if !in[0].IsEqual(New(-1, 2)) || !in[1].IsEqual(New(0, -3.5)) {
+		t.Errorf("AbsAll() modified its input: %v", in)
+	}
+}
+
+func TestVector2_SignAll(t *testing.T) {
+	in := []Vector2{New(-1, 2), New(0, -3.5), New(4, 0)}
+	want := []Vector2{New(-1, 1), New(0, -1), New(1, 0)}
+	got := SignAll(in)
+	if len(got) != len(want) {
+		t.Fatalf("SignAll() returned %d vectors, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if !got[i].IsEqual(want[i]) {
+			t.Errorf("SignAll()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if !in[0].IsEqual(New(-1, 2)) || !in[2].IsEqual(New(4, 0)) {
+		t.Errorf("SignAll() modified its input: %v", in)
+	}
+}
+
+func TestVector2_AssignMatchesValue(t *testing.T) {
+	a := New(1.25, -3.5)
+	b := New(-0.75, 8.125)
+
+	add := a
+	add.AddAssign(b)
+	if add != a.Add(b) {
+		t.Errorf("AddAssign() = %v, want %v", add, a.Add(b))
+	}
+
+	sub := a
+	sub.SubAssign(b)
+	if sub != a.Sub(b) {
+		t.Errorf("SubAssign() = %v, want %v", sub, a.Sub(b))
+	}
+
+	mul := a
+	mul.MulfAssign(0.3)
+	if mul != a.Mulf(0.3) {
+		t.Errorf("MulfAssign() = %v, want %v", mul, a.Mulf(0.3))
+	}
+
+	lerp := a
+	lerp.LerpAssign(b, 0.4)
+	if lerp != a.Lerp(b, 0.4) {
+		t.Errorf("LerpAssign() = %v, want %v", lerp, a.Lerp(b, 0.4))
+	}
+
+	norm := a
+	norm.Normalize()
+	if norm != a.Normalized() {
+		t.Errorf("Normalize() = %v, want %v", norm, a.Normalized())
+	}
+}
+
+func TestVector2_ValueMethodsDoNotMutate(t *testing.T) {
+	a := New(1.25, -3.5)
+	b := New(-0.75, 8.125)
+	a.Add(b)
+	a.Sub(b)
+	a.Mulf(0.3)
+	a.Lerp(b, 0.4)
+	a.Normalized()
+	if a != New(1.25, -3.5) || b != New(-0.75, 8.125) {
+		t.Errorf("value methods mutated their operands: a = %v, b = %v", a, b)
+	}
+}
+
+const benchParticles = 1024
+
+var benchSink Vector2
+
+func BenchmarkVector2_IntegrateValue(b *testing.B) {
+	pos := make([]Vector2, benchParticles)
+	vel := make([]Vector2, benchParticles)
+	for i := range vel {
+		vel[i] = New(1, 2)
+	}
+	acc := New(0, -9.8)
+	dt := 1.0 / 60.0
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range pos {
+			vel[i] = vel[i].Add(acc.Mulf(dt))
+			pos[i] = pos[i].Add(vel[i].Mulf(dt))
+		}
+	}
+	benchSink = pos[0]
+}
+
+func BenchmarkVector2_IntegrateInPlace(b *testing.B) {
+	pos := make([]Vector2, benchParticles)
+	vel := make([]Vector2, benchParticles)
+	for i := range vel {
+		vel[i] = New(1, 2)
+	}
+	acc := New(0, -9.8)
+	dt := 1.0 / 60.0
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range pos {
+			step := acc
+			step.MulfAssign(dt)
+			vel[i].AddAssign(step)
+			step = vel[i]
+			step.MulfAssign(dt)
+			pos[i].AddAssign(step)
+		}
+	}
+	benchSink = pos[0]
+}
+
+func TestVector2_InvalidInput(t *testing.T) {
+	v := New(1, 1)
+	tests := []struct {
+		name    string
+		checked func() (Vector2, error)
+		plain   func() Vector2
+		want    Vector2
+		wantErr error
+	}{
+		{"Reflect non-normalized", func() (Vector2, error) { return v.ReflectChecked(New(0, 2)) }, func() Vector2 { return v.Reflect(New(0, 2)) }, New(-1, 1), nil},
+		{"Reflect zero", func() (Vector2, error) { return v.ReflectChecked(Zero()) }, func() Vector2 { return v.Reflect(Zero()) }, v, zerogdscript.ErrZeroLength},
+		{"Slide non-normalized", func() (Vector2, error) { return v.SlideChecked(New(0, 3)) }, func() Vector2 { return v.Slide(New(0, 3)) }, New(1, 0), nil},
+		{"Slide zero", func() (Vector2, error) { return v.SlideChecked(Zero()) }, func() Vector2 { return v.Slide(Zero()) }, v, zerogdscript.ErrZeroLength},
+		{"Bounce non-normalized", func() (Vector2, error) { return v.BounceChecked(New(0, 2)) }, func() Vector2 { return v.Bounce(New(0, 2)) }, New(1, -1), nil},
+		{"Bounce zero", func() (Vector2, error) { return v.BounceChecked(Zero()) }, func() Vector2 { return v.Bounce(Zero()) }, v, zerogdscript.ErrZeroLength},
+	}
+	for _, tt := range tests {
+		got, err := tt.checked()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if !got.IsEqualApprox(tt.want) {
+			t.Errorf("%s: checked = %v, want %v", tt.name, got, tt.want)
+		}
+		if plain := tt.plain(); !plain.IsEqualApprox(tt.want) {
+			t.Errorf("%s: plain = %v, want %v", tt.name, plain, tt.want)
+		}
+	}
+
+	// Division by zero follows IEEE-754 instead of forcing +Inf.
+	q := New(-1, 0).Divf(0)
+	if !math.IsInf(q.X, -1) || !math.IsNaN(q.Y) {
+		t.Errorf("Divf(0) = %v, want (-Inf, NaN)", q)
+	}
+	if q := New(-1, 1).Div(Zero()); !math.IsInf(q.X, -1) || !math.IsInf(q.Y, 1) {
+		t.Errorf("Div(Zero()) = %v, want (-Inf, +Inf)", q)
+	}
+}
+
+func TestVector2_IsWithinCone(t *testing.T) {
+	forward := New(1, 0)
+	tests := []struct {
+		name      string
+		direction Vector2
+		halfAngle float64
+		want      bool
+	}{
+		{"straight ahead", New(5, 0), math.Pi / 4, true},
+		{"inside", New(1, 0.5), math.Pi / 4, true},
+		{"on the boundary", New(1, 1), math.Pi / 4, true},
+		{"on the other boundary", New(1, -1), math.Pi / 4, true},
+		{"just outside", New(1, 1.001), math.Pi / 4, false},
+		{"behind", New(-1, 0), math.Pi / 4, false},
+		{"wide cone", New(-1, 1), 3 * math.Pi / 4, true},
+		{"outside wide cone", New(-1, 0.9), 3 * math.Pi / 4, false},
+		{"zero direction", Zero(), math.Pi / 4, false},
+	}
+	for _, tt := range tests {
+		if got := tt.direction.IsWithinCone(forward, tt.halfAngle); got != tt.want {
+			t.Errorf("%s: %v.IsWithinCone(%v, %v) = %v, want %v", tt.name, tt.direction, forward, tt.halfAngle, got, tt.want)
+		}
+	}
+	if New(1, 0).IsWithinCone(Zero(), math.Pi) {
+		t.Errorf("zero forward should never contain a direction")
+	}
+}
+
+func TestVector2_Validate(t *testing.T) {
+	if err := New(1, -2).Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	for _, tt := range []struct {
+		v    Vector2
+		want string
+	}{
+		{New(math.NaN(), 0), "Vector2.X: value is not finite: NaN"},
+		{New(0, math.Inf(-1)), "Vector2.Y: value is not finite: -Inf"},
+	} {
+		err := tt.v.Validate()
+		if err == nil || err.Error() != tt.want || !errors.Is(err, zerogdscript.ErrNonFinite) {
+			t.Errorf("%v.Validate() = %v, want %q", tt.v, err, tt.want)
+		}
+	}
+}
+
+func TestVector2_AsArray(t *testing.T) {
+	v := New(1, 2)
+	if got := v.AsArray(); got != [2]float64{1, 2} {
+		t.Errorf("AsArray() = %v, want [1 2]", got)
+	}
+	if got := FromArray(v.AsArray()); got != v {
+		t.Errorf("FromArray(AsArray()) = %v, want %v", got, v)
+	}
+}
@@ -0,0 +1,70 @@
+package vector2i
+
+/**************************************************************************/
+/*  vector2i.h                                                            */
+/**************************************************************************/
+/*                         This file is part of:                          */
+/*                             GODOT ENGINE                               */
+/*                        https://godotengine.org                         */
+/*                                                                        */
+/*                        Ported to Go on 5/2024 from					  */
+/*                    Godot Engine v4.2.1.stable.official                 */
+/*                                                                        */
+/**************************************************************************/
+/* Copyright (c) 2014-present Godot Engine contributors (see AUTHORS.md). */
+/* Copyright (c) 2007-2014 Juan Linietsky, Ariel Manzur.                  */
+/*                                                                        */
+/* Permission is hereby granted, free of charge, to any person obtaining  */
+/* a copy of this software and associated documentation files (the        */
+/* "Software"), to deal in the Software without restriction, including    */
+/* without limitation the rights to use, copy, modify, merge, publish,    */
+/* distribute, sublicense, and/or sell copies of the Software, and to     */
+/* permit persons to whom the Software is furnished to do so, subject to  */
+/* the following conditions:                                              */
+/*                                                                        */
+/* The above copyright notice and this permission notice shall be         */
+/* included in all copies or substantial portions of the Software.        */
+/*                                                                        */
+/* THE SOFTWARE IS PROVIDED "AS IS", WITHOUT WARRANTY OF ANY KIND,        */
+/* EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF     */
+/* MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT. */
+/* IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY   */
+/* CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,   */
+/* TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE      */
+/* SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.                 */
+/**************************************************************************/
+
+import (
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+// Vector2i is a 2D vector of integers, used for grid coordinates.
+type Vector2i struct {
+	X int `json:"x"`
+	Y int `json:"y"`
+}
+
+func New(x, y int) Vector2i {
+	return Vector2i{X: x, Y: y}
+}
+
+func Zero() Vector2i {
+	return New(0, 0)
+}
+
+func (v Vector2i) Add(b Vector2i) Vector2i {
+	v.X += b.X
+	v.Y += b.Y
+	return v
+}
+
+func (v Vector2i) Sub(b Vector2i) Vector2i {
+	v.X -= b.X
+	v.Y -= b.Y
+	return v
+}
+
+// ToVector2 converts the vector to a vector2.Vector2.
+func (v Vector2i) ToVector2() vector2.Vector2 {
+	return vector2.New(float64(v.X), float64(v.Y))
+}
@@ -0,0 +1,25 @@
+package vector2i
+
+import (
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/vector2"
+)
+
+func TestVector2i_Add(t *testing.T) {
+	if got := New(1, -2).Add(New(3, 4)); got != New(4, 2) {
+		t.Errorf("Add = %v, want (4, 2)", got)
+	}
+}
+
+func TestVector2i_Sub(t *testing.T) {
+	if got := New(1, -2).Sub(New(3, 4)); got != New(-2, -6) {
+		t.Errorf("Sub = %v, want (-2, -6)", got)
+	}
+}
+
+func TestVector2i_ToVector2(t *testing.T) {
+	if got := New(3, -7).ToVector2(); got != vector2.New(3, -7) {
+		t.Errorf("ToVector2 = %v, want (3, -7)", got)
+	}
+}
@@ -0,0 +1,38 @@
+package vector3
+
+import (
+	"math"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/rng"
+)
+
+// SampleHemisphere returns a random unit vector drawn uniformly from the
+// hemisphere around normal. A non-normalized normal is normalized first; a
+// zero normal returns a zero vector.
+func SampleHemisphere(normal Vector3, rng *rng.Rng) Vector3 {
+	// Uniform over the hemisphere means uniform in height.
+	z := rng.Randd()
+	return fromHemisphere(normal, math.Sqrt(1-z*z), z, rng.Randd())
+}
+
+// SampleCosineWeighted returns a random unit vector in the hemisphere around
+// normal, with a density proportional to the cosine of its angle to normal.
+// This is the usual importance sampling for diffuse lighting. A
+// non-normalized normal is normalized first; a zero normal returns a zero
+// vector.
+func SampleCosineWeighted(normal Vector3, rng *rng.Rng) Vector3 {
+	// Malley's method: project a uniform disk sample up onto the hemisphere.
+	r2 := rng.Randd()
+	return fromHemisphere(normal, math.Sqrt(r2), math.Sqrt(1-r2), rng.Randd())
+}
+
+// fromHemisphere maps a sample at radius r and height z around the pole,
+// rotated by turn full turns, into the frame of normal.
+func fromHemisphere(normal Vector3, r, z, turn float64) Vector3 {
+	if normal.LengthSquared() == 0 {
+		return Zero()
+	}
+	tangent, bitangent := normal.TangentBasis()
+	phi := 2 * math.Pi * turn
+	return tangent.Mulf(r * math.Cos(phi)).Add(bitangent.Mulf(r * math.Sin(phi))).Add(normal.Normalized().Mulf(z))
+}
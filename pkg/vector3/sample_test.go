@@ -0,0 +1,43 @@
+package vector3
+
+import (
+	"testing"
+
+	"github.com/Anaxarchus/zero-gdscript/pkg/rng"
+)
+
+func testSampler(t *testing.T, name string, sample func(Vector3, *rng.Rng) Vector3, wantMeanCos float64) {
+	const n = 20000
+	r := rng.New(42)
+	for _, normal := range []Vector3{New(0, 0, 1), New(0, 0, -1), New(1, 2, -3), New(0, 5, 0)} {
+		unit := normal.Normalized()
+		var sum Vector3
+		for i := 0; i < n; i++ {
+			s := sample(normal, r)
+			if !s.IsNormalized() {
+				t.Fatalf("%s(%v) = %v is not unit length", name, normal, s)
+			}
+			if s.Dot(unit) < 0 {
+				t.Fatalf("%s(%v) = %v is outside the hemisphere", name, normal, s)
+			}
+			sum = sum.Add(s)
+		}
+		mean := sum.Divf(n)
+		// The mean points along the normal with length E[cos θ]. The
+		// tolerance is several standard errors for n samples.
+		if d := mean.DistanceTo(unit.Mulf(wantMeanCos)); d > 0.02 {
+			t.Errorf("%s(%v): mean direction %v, want about %v", name, normal, mean, unit.Mulf(wantMeanCos))
+		}
+	}
+	if s := sample(Zero(), r); s != Zero() {
+		t.Errorf("%s(Zero()) = %v, want Zero()", name, s)
+	}
+}
+
+func TestVector3_SampleHemisphere(t *testing.T) {
+	testSampler(t, "SampleHemisphere", SampleHemisphere, 0.5)
+}
+
+func TestVector3_SampleCosineWeighted(t *testing.T) {
+	testSampler(t, "SampleCosineWeighted", SampleCosineWeighted, 2.0/3)
+}
@@ -38,6 +38,7 @@ import (
 	"math"
 
 	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/internal/utils"
 	"github.com/Anaxarchus/zero-gdscript/pkg/basis"
 )
 
@@ -73,12 +74,16 @@ func (v *Vector3) set(x, y, z float64) {
 	v.Z = z
 }
 
-func (v Vector3) IsUp() bool {
-	return v.IsEqualApprox(New(0, 0, -1))
+// IsUp reports whether v points into the half-space of up, that is whether
+// their dot product is positive. Neither vector needs to be normalized. A
+// vector perpendicular to up, or a zero vector, is neither up nor down.
+func (v Vector3) IsUp(up Vector3) bool {
+	return v.Dot(up) > 0
 }
 
-func (v Vector3) IsDown() bool {
-	return v.IsEqualApprox(New(0, 0, 1))
+// IsDown reports whether v points away from up, the opposite of IsUp.
+func (v Vector3) IsDown(up Vector3) bool {
+	return v.Dot(up) < 0
 }
 
 func (v Vector3) Add(with Vector3) Vector3 {
@@ -111,36 +116,41 @@ func (v Vector3) Mulf(with float64) Vector3 {
 	return v
 }
 
+// Div divides v by with component-wise. Division by zero follows IEEE-754, as
+// in Godot: a zero divisor gives an infinity signed by the numerator and the
+// zero, and 0/0 gives NaN.
 func (v Vector3) Div(with Vector3) Vector3 {
-	if with.X == 0 {
-		v.X = math.Inf(1)
-	} else {
-		v.X /= with.X
-	}
-
-	if with.Y == 0 {
-		v.Y = math.Inf(1)
-	} else {
-		v.Y /= with.Y
-	}
-
-	if with.Z == 0 {
-		v.Z = math.Inf(1)
-	} else {
-		v.Z /= with.Z
-	}
+	v.set(v.X/with.X, v.Y/with.Y, v.Z/with.Z)
 	return v
 }
 
+// Divf divides v by with. Division by zero follows IEEE-754 as for Div.
 func (v Vector3) Divf(with float64) Vector3 {
-	if with == 0 {
-		v.set(math.Inf(1), math.Inf(1), math.Inf(1))
-	} else {
-		v.set(v.X/with, v.Y/with, v.Z/with)
-	}
+	v.set(v.X/with, v.Y/with, v.Z/with)
 	return v
 }
 
+// AddAssign adds with to v in place.
+func (v *Vector3) AddAssign(with Vector3) {
+	v.X += with.X
+	v.Y += with.Y
+	v.Z += with.Z
+}
+
+// SubAssign subtracts with from v in place.
+func (v *Vector3) SubAssign(with Vector3) {
+	v.X -= with.X
+	v.Y -= with.Y
+	v.Z -= with.Z
+}
+
+// MulfAssign scales v by with in place.
+func (v *Vector3) MulfAssign(with float64) {
+	v.X *= with
+	v.Y *= with
+	v.Z *= with
+}
+
 func (v Vector3) Cross(with Vector3) Vector3 {
 	v.set(
 		(v.Y*with.Z)-(v.Z*with.Y),
@@ -159,6 +169,49 @@ func (v Vector3) Abs() Vector3 {
 	return v
 }
 
+// Min returns the component-wise minimum of v and b.
+func (v Vector3) Min(b Vector3) Vector3 {
+	v.set(math.Min(v.X, b.X), math.Min(v.Y, b.Y), math.Min(v.Z, b.Z))
+	return v
+}
+
+// Max returns the component-wise maximum of v and b.
+func (v Vector3) Max(b Vector3) Vector3 {
+	v.set(math.Max(v.X, b.X), math.Max(v.Y, b.Y), math.Max(v.Z, b.Z))
+	return v
+}
+
+// MaxAxisIndex returns the index of the largest component, 0 for X, 1 for Y
+// and 2 for Z. As in Godot, a tie goes to the earlier axis.
+func (v Vector3) MaxAxisIndex() int {
+	if v.X < v.Y {
+		if v.Y < v.Z {
+			return 2
+		}
+		return 1
+	}
+	if v.X < v.Z {
+		return 2
+	}
+	return 0
+}
+
+// MinAxisIndex returns the index of the smallest component, 0 for X, 1 for Y
+// and 2 for Z. Ties resolve as in Godot, which favours the later axis: X and
+// Y tied for smallest gives 1, and any tie with Z gives 2.
+func (v Vector3) MinAxisIndex() int {
+	if v.X < v.Y {
+		if v.X < v.Z {
+			return 0
+		}
+		return 2
+	}
+	if v.Y < v.Z {
+		return 1
+	}
+	return 2
+}
+
 func (v Vector3) Sign() Vector3 {
 	v.set(zerogdscript.Sign(v.X), zerogdscript.Sign(v.Y), zerogdscript.Sign(v.Z))
 	return v
@@ -179,6 +232,44 @@ func (v Vector3) Round() Vector3 {
 	return v
 }
 
+func (v Vector3) Clamp(min, max Vector3) Vector3 {
+	v.X = zerogdscript.Clampf(v.X, min.X, max.X)
+	v.Y = zerogdscript.Clampf(v.Y, min.Y, max.Y)
+	v.Z = zerogdscript.Clampf(v.Z, min.Z, max.Z)
+	return v
+}
+
+func (v Vector3) Clampf(min, max float64) Vector3 {
+	v.X = zerogdscript.Clampf(v.X, min, max)
+	v.Y = zerogdscript.Clampf(v.Y, min, max)
+	v.Z = zerogdscript.Clampf(v.Z, min, max)
+	return v
+}
+
+func (v Vector3) Snapped(step Vector3) Vector3 {
+	v.X = zerogdscript.Snapped(v.X, step.X)
+	v.Y = zerogdscript.Snapped(v.Y, step.Y)
+	v.Z = zerogdscript.Snapped(v.Z, step.Z)
+	return v
+}
+
+func (v Vector3) Snappedf(step float64) Vector3 {
+	v.X = zerogdscript.Snapped(v.X, step)
+	v.Y = zerogdscript.Snapped(v.Y, step)
+	v.Z = zerogdscript.Snapped(v.Z, step)
+	return v
+}
+
+// SnappedWithOffset snaps each component to the nearest point of a grid with
+// spacing step whose lines are shifted by offset, so 0.6 snaps to 0.75 on a
+// 0.5 grid offset by 0.25. A component with a zero step is left unchanged.
+func (v Vector3) SnappedWithOffset(step, offset Vector3) Vector3 {
+	v.X = zerogdscript.SnapScalar(offset.X, step.X, v.X)
+	v.Y = zerogdscript.SnapScalar(offset.Y, step.Y, v.Y)
+	v.Z = zerogdscript.SnapScalar(offset.Z, step.Z, v.Z)
+	return v
+}
+
 func (v Vector3) Lerp(to Vector3, weight float64) Vector3 {
 	v.set(
 		zerogdscript.Lerp(v.X, to.X, weight),
@@ -188,7 +279,19 @@ func (v Vector3) Lerp(to Vector3, weight float64) Vector3 {
 	return v
 }
 
+// LerpAssign interpolates v towards to by weight in place.
+func (v *Vector3) LerpAssign(to Vector3, weight float64) {
+	v.set(
+		zerogdscript.Lerp(v.X, to.X, weight),
+		zerogdscript.Lerp(v.Y, to.Y, weight),
+		zerogdscript.Lerp(v.Z, to.Z, weight),
+	)
+}
+
 func (v Vector3) Slerp(to Vector3, weight float64) Vector3 {
+	if zerogdscript.IsDebug() {
+		zerogdscript.DebugValidate("Vector3.Slerp", v, to)
+	}
 	// This method seems more complicated than it really is, since we write out
 	// the internals of some methods for efficiency (mainly, checking length).
 	sl2 := v.LengthSquared()
@@ -262,6 +365,119 @@ func (v Vector3) AngleTo(to Vector3) float64 {
 	return math.Atan2(v.Cross(to).Length(), v.Dot(to))
 }
 
+// Validate returns a *zerogdscript.ValidationError naming the first
+// component that is NaN or infinite.
+func (v Vector3) Validate() error {
+	if err := zerogdscript.ValidateFinite("Vector3", "X", v.X); err != nil {
+		return err
+	}
+	if err := zerogdscript.ValidateFinite("Vector3", "Y", v.Y); err != nil {
+		return err
+	}
+	return zerogdscript.ValidateFinite("Vector3", "Z", v.Z)
+}
+
+// IsWithinCone reports whether v points within halfAngle radians of forward,
+// boundary included. It compares dot products instead of calling acos, so it
+// is cheap enough for per-frame vision checks. A zero v or forward is never
+// within the cone.
+func (v Vector3) IsWithinCone(forward Vector3, halfAngle float64) bool {
+	l2 := v.LengthSquared() * forward.LengthSquared()
+	if l2 == 0 {
+		return false
+	}
+	// Compare signed squared cosines, which order the same way as the cosines.
+	d := v.Dot(forward)
+	c := math.Cos(halfAngle)
+	return d*math.Abs(d)/l2 >= c*math.Abs(c)-zerogdscript.CMP_EPSILON2
+}
+
+// TangentBasis returns two unit vectors that are perpendicular to v and to
+// each other, with tangent × bitangent pointing along v. It uses Frisvad's
+// branchless construction in the revised form by Duff et al., which takes
+// the sign of Z instead of dividing by 1+Z, so it stays accurate all the
+// way to the -Z pole. A non-normalized v is normalized first; a zero v
+// returns the X and Y axes.
+func (v Vector3) TangentBasis() (tangent, bitangent Vector3) {
+	if v.LengthSquared() == 0 {
+		return New(1, 0, 0), New(0, 1, 0)
+	}
+	n := v.Normalized()
+	sign := math.Copysign(1, n.Z)
+	a := -1 / (sign + n.Z)
+	b := n.X * n.Y * a
+	tangent = New(1+sign*n.X*n.X*a, sign*b, -sign*n.X)
+	bitangent = New(b, sign+n.Y*n.Y*a, -n.Y)
+	return tangent, bitangent
+}
+
+// Orthogonal returns a unit vector perpendicular to v. It crosses v with the
+// axis along which v has its smallest component, which keeps the cross
+// product well away from zero. Unlike TangentBasis, a zero or near-zero v
+// returns the zero vector.
+func (v Vector3) Orthogonal() Vector3 {
+	if zerogdscript.IsZeroApprox(v.X) && zerogdscript.IsZeroApprox(v.Y) && zerogdscript.IsZeroApprox(v.Z) {
+		return Zero()
+	}
+	ax, ay, az := math.Abs(v.X), math.Abs(v.Y), math.Abs(v.Z)
+	var axis Vector3
+	switch {
+	case ax <= ay && ax <= az:
+		axis = New(1, 0, 0)
+	case ay <= az:
+		axis = New(0, 1, 0)
+	default:
+		axis = New(0, 0, 1)
+	}
+	return v.Cross(axis).Normalized()
+}
+
+// Spring advances a damped spring pulling v toward target by dt seconds and
+// returns the new position and velocity, per axis as zerogdscript.Spring.
+func (v Vector3) Spring(target, velocity Vector3, stiffness, damping, dt float64) (Vector3, Vector3) {
+	v.X, velocity.X = zerogdscript.Spring(v.X, target.X, velocity.X, stiffness, damping, dt)
+	v.Y, velocity.Y = zerogdscript.Spring(v.Y, target.Y, velocity.Y, stiffness, damping, dt)
+	v.Z, velocity.Z = zerogdscript.Spring(v.Z, target.Z, velocity.Z, stiffness, damping, dt)
+	return v, velocity
+}
+
+// SmoothDamp moves v toward target like a critically damped spring, as
+// zerogdscript.SmoothDamp but along the straight line to target, so maxSpeed
+// caps the length of the velocity rather than each axis. velocity is updated
+// in place. The result never passes target and lands exactly on it, with the
+// velocity zeroed, once within CMP_EPSILON. A smoothTime below 0.0001 moves
+// straight to target without smoothing.
+func (v Vector3) SmoothDamp(target Vector3, velocity *Vector3, smoothTime, maxSpeed, delta float64) Vector3 {
+	if delta <= 0 {
+		return v
+	}
+	if smoothTime < 0.0001 {
+		step := target.Sub(v)
+		if l := step.Length(); l > maxSpeed*delta {
+			step = step.Mulf(maxSpeed * delta / l)
+			*velocity = step.Divf(delta)
+			return v.Add(step)
+		}
+		*velocity = Zero()
+		return target
+	}
+	omega := 2.0 / smoothTime
+	x := omega * delta
+	decay := 1.0 / (1.0 + x + 0.48*x*x + 0.235*x*x*x)
+	change := v.Sub(target)
+	if l, maxChange := change.Length(), maxSpeed*smoothTime; l > maxChange {
+		change = change.Mulf(maxChange / l)
+	}
+	temp := velocity.Add(change.Mulf(omega)).Mulf(delta)
+	*velocity = velocity.Sub(temp.Mulf(omega)).Mulf(decay)
+	output := v.Sub(change).Add(change.Add(temp).Mulf(decay))
+	if target.Sub(v).Dot(output.Sub(target)) > 0 || output.DistanceTo(target) <= zerogdscript.CMP_EPSILON {
+		*velocity = Zero()
+		return target
+	}
+	return output
+}
+
 func (v Vector3) SignedAngleTo(to, axis Vector3) float64 {
 	cross_to := v.Cross(to)
 	unsigned_angle := math.Atan2(cross_to.Length(), v.Dot(to))
@@ -311,13 +527,46 @@ func (v Vector3) Normalized() Vector3 {
 	return v
 }
 
+// TryNormalized returns v scaled to unit length and true, or the zero vector
+// and false when every component of v is within CMP_EPSILON of zero, where
+// the direction is too unreliable to use.
+func (v Vector3) TryNormalized() (Vector3, bool) {
+	if zerogdscript.IsZeroApprox(v.X) && zerogdscript.IsZeroApprox(v.Y) && zerogdscript.IsZeroApprox(v.Z) {
+		return Zero(), false
+	}
+	return v.Normalized(), true
+}
+
+// SafeNormalized is like Normalized but returns fallback, as given, when v is
+// near zero in the sense of TryNormalized.
+func (v Vector3) SafeNormalized(fallback Vector3) Vector3 {
+	if n, ok := v.TryNormalized(); ok {
+		return n
+	}
+	return fallback
+}
+
 func (v Vector3) IsNormalized() bool {
 	// use length_squared() instead of length() to avoid sqrt(), makes it more stringent.
 	return zerogdscript.IsEqualApprox(v.LengthSquared(), 1.0)
 }
 
 func (v Vector3) IsEqualApprox(b Vector3) bool {
-	return zerogdscript.IsEqualApprox(v.X, b.X) && zerogdscript.IsEqualApprox(v.Y, b.Y) && zerogdscript.IsEqualApprox(v.Z, b.Z)
+	return v.IsEqualApproxTol(b, zerogdscript.CMP_EPSILON)
+}
+
+// IsEqualApproxTol reports whether each component of v differs from b by less
+// than tolerance. Use it with a tolerance that fits the scale of the data, for
+// example when comparing large coordinates.
+func (v Vector3) IsEqualApproxTol(b Vector3, tolerance float64) bool {
+	return zerogdscript.IsEqualApproxTol(v.X, b.X, tolerance) &&
+		zerogdscript.IsEqualApproxTol(v.Y, b.Y, tolerance) &&
+		zerogdscript.IsEqualApproxTol(v.Z, b.Z, tolerance)
+}
+
+// IsFinite reports whether no component is NaN or an infinity of either sign.
+func (v Vector3) IsFinite() bool {
+	return zerogdscript.IsFinitef(v.X) && zerogdscript.IsFinitef(v.Y) && zerogdscript.IsFinitef(v.Z)
 }
 
 func (v Vector3) Inverse() Vector3 {
@@ -325,26 +574,67 @@ func (v Vector3) Inverse() Vector3 {
 	return v
 }
 
-// slide returns the component of the vector along the given plane, specified by its normal vector.
+// Slide returns the component of the vector along the given plane, specified by its normal vector.
+// A non-normalized normal is normalized first; a zero normal returns v unchanged.
 func (v Vector3) Slide(normal Vector3) Vector3 {
-	if !normal.IsNormalized() {
-		return v
+	res, _ := v.SlideChecked(normal)
+	return res
+}
+
+// SlideChecked is like Slide but returns ErrZeroLength for a zero normal.
+func (v Vector3) SlideChecked(normal Vector3) (Vector3, error) {
+	normal, err := unitNormal(normal)
+	if err != nil {
+		return v, err
 	}
-	return v.Sub(normal.Mulf(v.Dot(normal)))
+	return v.Sub(normal.Mulf(v.Dot(normal))), nil
 }
 
+// Bounce returns the vector "bounced off" from a plane defined by the given normal.
+// A non-normalized normal is normalized first; a zero normal returns v unchanged.
 func (v Vector3) Bounce(normal Vector3) Vector3 {
-	return v.Reflect(normal).Mulf(-1.0)
+	res, _ := v.BounceChecked(normal)
+	return res
 }
 
+// BounceChecked is like Bounce but returns ErrZeroLength for a zero normal.
+func (v Vector3) BounceChecked(normal Vector3) (Vector3, error) {
+	res, err := v.ReflectChecked(normal)
+	if err != nil {
+		return v, err
+	}
+	return res.Mulf(-1.0), nil
+}
+
+// Reflect returns the vector reflected from a plane defined by the given normal.
+// A non-normalized normal is normalized first; a zero normal returns v unchanged.
 func (v Vector3) Reflect(normal Vector3) Vector3 {
-	if !normal.IsNormalized() {
-		return v
+	res, _ := v.ReflectChecked(normal)
+	return res
+}
+
+// ReflectChecked is like Reflect but returns ErrZeroLength for a zero normal.
+func (v Vector3) ReflectChecked(normal Vector3) (Vector3, error) {
+	normal, err := unitNormal(normal)
+	if err != nil {
+		return v, err
 	}
-	return normal.Mulf(v.Dot(normal)).Mulf(2.0).Sub(v)
+	return normal.Mulf(v.Dot(normal)).Mulf(2.0).Sub(v), nil
 	//return 2.0 * normal * Dot(normal) - v
 }
 
+// unitNormal returns normal with unit length, leaving it untouched when it is
+// already normalized within tolerance.
+func unitNormal(normal Vector3) (Vector3, error) {
+	if normal.IsNormalized() {
+		return normal, nil
+	}
+	if normal.LengthSquared() == 0 {
+		return normal, zerogdscript.ErrZeroLength
+	}
+	return normal.Normalized(), nil
+}
+
 // Rotate the current Vector3 around the provided axis by the specified angle.
 func (v *Vector3) Rotate(axis Vector3, angle float64) {
 	b := basis.FromAxisAndAngle(axis.getSlice(), angle)
@@ -368,3 +658,133 @@ func (v *Vector3) setSlice(slice [3]float64) {
 	v.Y = slice[1]
 	v.Z = slice[2]
 }
+
+// AbsAll returns a new slice holding the absolute value of each vector in s.
+func AbsAll(s []Vector3) []Vector3 {
+	res := make([]Vector3, len(s))
+	for i, v := range s {
+		res[i] = v.Abs()
+	}
+	return res
+}
+
+// SignAll returns a new slice holding the sign of each vector in s.
+func SignAll(s []Vector3) []Vector3 {
+	res := make([]Vector3, len(s))
+	for i, v := range s {
+		res[i] = v.Sign()
+	}
+	return res
+}
+
+// Centroid returns the average of points, summing each axis with
+// utils.KahanSum so large point clouds do not lose precision. An empty slice
+// returns Zero.
+func Centroid(points []Vector3) Vector3 {
+	if len(points) == 0 {
+		return Zero()
+	}
+	xs := make([]float64, len(points))
+	ys := make([]float64, len(points))
+	zs := make([]float64, len(points))
+	for i, p := range points {
+		xs[i], ys[i], zs[i] = p.X, p.Y, p.Z
+	}
+	n := float64(len(points))
+	return New(utils.KahanSum(xs)/n, utils.KahanSum(ys)/n, utils.KahanSum(zs)/n)
+}
+
+// AsArray returns the components as [X, Y, Z].
+func (v Vector3) AsArray() [3]float64 {
+	return [3]float64{v.X, v.Y, v.Z}
+}
+
+// FromArray returns the vector with components [X, Y, Z].
+func FromArray(a [3]float64) Vector3 {
+	return Vector3{X: a[0], Y: a[1], Z: a[2]}
+}
+
+// Vector3SliceToFloats writes src into dst as consecutive X, Y, Z triples and
+// returns dst resliced to 3*len(src). It only allocates when dst lacks the
+// capacity.
+func Vector3SliceToFloats(dst []float64, src []Vector3) []float64 {
+	n := 3 * len(src)
+	if cap(dst) < n {
+		dst = make([]float64, n)
+	}
+	dst = dst[:n]
+	for i, v := range src {
+		dst[3*i], dst[3*i+1], dst[3*i+2] = v.X, v.Y, v.Z
+	}
+	return dst
+}
+
+// FloatsToVector3Slice reads consecutive X, Y, Z triples from src into dst and
+// returns dst resliced to len(src)/3. Trailing floats that do not make a full
+// triple are ignored. It only allocates when dst lacks the capacity.
+func FloatsToVector3Slice(dst []Vector3, src []float64) []Vector3 {
+	n := len(src) / 3
+	if cap(dst) < n {
+		dst = make([]Vector3, n)
+	}
+	dst = dst[:n]
+	for i := range dst {
+		dst[i] = Vector3{X: src[3*i], Y: src[3*i+1], Z: src[3*i+2]}
+	}
+	return dst
+}
+
+// CatmullRom evaluates the cardinal spline segment from p1 to p2 at t in [0, 1]
+// with the given tension, per component. See zerogdscript.CatmullRom; tension
+// 0.5 is the uniform Catmull-Rom spline.
+func CatmullRom(p0, p1, p2, p3 Vector3, t, tension float64) Vector3 {
+	return Vector3{
+		X: zerogdscript.CatmullRom(p0.X, p1.X, p2.X, p3.X, t, tension),
+		Y: zerogdscript.CatmullRom(p0.Y, p1.Y, p2.Y, p3.Y, t, tension),
+		Z: zerogdscript.CatmullRom(p0.Z, p1.Z, p2.Z, p3.Z, t, tension),
+	}
+}
+
+// CatmullRomCentripetal evaluates the centripetal Catmull-Rom segment from p1
+// to p2 at t in [0, 1]. The knots are spaced by the square root of the
+// distance between consecutive points, so unevenly spaced points do not
+// produce the cusps and self-intersecting loops of the uniform spline.
+func CatmullRomCentripetal(p0, p1, p2, p3 Vector3, t float64) Vector3 {
+	pre, to, post := zerogdscript.CentripetalKnots(p0.DistanceTo(p1), p1.DistanceTo(p2), p2.DistanceTo(p3))
+	return Vector3{
+		X: zerogdscript.CubicInterpolateInTime(p1.X, p2.X, p0.X, p3.X, t, to, pre, post),
+		Y: zerogdscript.CubicInterpolateInTime(p1.Y, p2.Y, p0.Y, p3.Y, t, to, pre, post),
+		Z: zerogdscript.CubicInterpolateInTime(p1.Z, p2.Z, p0.Z, p3.Z, t, to, pre, post),
+	}
+}
+
+// Step returns 0 for each component of v below the matching edge component
+// and 1 otherwise, like GLSL's step.
+func Step(edge, v Vector3) Vector3 {
+	return Vector3{
+		X: zerogdscript.Step(edge.X, v.X),
+		Y: zerogdscript.Step(edge.Y, v.Y),
+		Z: zerogdscript.Step(edge.Z, v.Z),
+	}
+}
+
+// Smoothstep returns the Hermite interpolation 3t^2 - 2t^3 of each component
+// of v between the matching components of edgeLow and edgeHigh, like GLSL's
+// smoothstep. Unlike zerogdscript.Smoothstep, which follows Godot, equal
+// edges give Step(edgeLow, v) for that component instead of the edge value.
+func Smoothstep(edgeLow, edgeHigh, v Vector3) Vector3 {
+	return Vector3{
+		X: smoothstep(edgeLow.X, edgeHigh.X, v.X),
+		Y: smoothstep(edgeLow.Y, edgeHigh.Y, v.Y),
+		Z: smoothstep(edgeLow.Z, edgeHigh.Z, v.Z),
+	}
+}
+
+// smoothstep is GLSL's smoothstep for one component. See Smoothstep.
+func smoothstep(edgeLow, edgeHigh, x float64) float64 {
+	if edgeLow == edgeHigh {
+		return zerogdscript.Step(edgeLow, x)
+	}
+	t := zerogdscript.Clampf((x-edgeLow)/(edgeHigh-edgeLow), 0, 1)
+	return t * t * (3 - 2*t)
+}
@@ -1,6 +1,13 @@
 package vector3
 
-import "testing"
+import (
+	"errors"
+	"math"
+	"testing"
+
+	zerogdscript "github.com/Anaxarchus/zero-gdscript"
+	"github.com/Anaxarchus/zero-gdscript/pkg/rng"
+)
 
 func TestVector3_CrossVector3(t *testing.T) {}
 
@@ -18,9 +25,45 @@ func TestVector3_Mul(t *testing.T) {}
 
 func TestVector3_Mulf(t *testing.T) {}
 
-func TestVector3_Div(t *testing.T) {}
-
-func TestVector3_Divf(t *testing.T) {}
+func TestVector3_Div(t *testing.T) {
+	if got, want := New(6, -3, 1).Div(New(2, 3, -4)), New(3, -1, -0.25); got != want {
+		t.Errorf("Div = %v, want %v", got, want)
+	}
+
+	// A zero divisor follows IEEE-754, like Godot: the sign of the infinity
+	// comes from the numerator and the sign of the zero, and 0/0 is NaN.
+	v := New(2, -2, 0)
+	q := v.Div(Zero())
+	if !math.IsInf(q.X, 1) || !math.IsInf(q.Y, -1) || !math.IsNaN(q.Z) {
+		t.Errorf("%v.Div(0) = %v, want (+Inf, -Inf, NaN)", v, q)
+	}
+	negZero := math.Copysign(0, -1)
+	q = v.Div(New(negZero, negZero, negZero))
+	if !math.IsInf(q.X, -1) || !math.IsInf(q.Y, 1) || !math.IsNaN(q.Z) {
+		t.Errorf("%v.Div(-0) = %v, want (-Inf, +Inf, NaN)", v, q)
+	}
+	// Only the zero components of the divisor are affected.
+	q = v.Div(New(0, 4, 1))
+	if !math.IsInf(q.X, 1) || q.Y != -0.5 || q.Z != 0 {
+		t.Errorf("%v.Div((0, 4, 1)) = %v, want (+Inf, -0.5, 0)", v, q)
+	}
+}
+
+func TestVector3_Divf(t *testing.T) {
+	if got, want := New(6, -3, 1).Divf(2), New(3, -1.5, 0.5); got != want {
+		t.Errorf("Divf = %v, want %v", got, want)
+	}
+
+	v := New(2, -2, 0)
+	q := v.Divf(0)
+	if !math.IsInf(q.X, 1) || !math.IsInf(q.Y, -1) || !math.IsNaN(q.Z) {
+		t.Errorf("%v.Divf(0) = %v, want (+Inf, -Inf, NaN)", v, q)
+	}
+	q = v.Divf(math.Copysign(0, -1))
+	if !math.IsInf(q.X, -1) || !math.IsInf(q.Y, 1) || !math.IsNaN(q.Z) {
+		t.Errorf("%v.Divf(-0) = %v, want (-Inf, +Inf, NaN)", v, q)
+	}
+}
 
 func TestVector3_Cross(t *testing.T) {}
 
@@ -76,6 +119,77 @@ func TestVector3_IsNormalized(t *testing.T) {}
 
 func TestVector3_IsEqualApprox(t *testing.T) {}
 
+func TestVector3_Step(t *testing.T) {
+	edge := New(0.5, -1, 0)
+	if got, want := Step(edge, New(0.4, -1, 3)), New(0, 1, 1); got != want {
+		t.Errorf("Step(%v, ...) = %v, want %v", edge, got, want)
+	}
+	if got, want := Step(edge, New(0.5, -1.1, -0.1)), New(1, 0, 0); got != want {
+		t.Errorf("Step(%v, ...) = %v, want %v", edge, got, want)
+	}
+}
+
+func TestVector3_Smoothstep(t *testing.T) {
+	low, high := New(0, 2, -1), New(1, 4, 1)
+	for _, tt := range []struct {
+		v, want Vector3
+	}{
+		{New(-1, 1, -2), New(0, 0, 0)},
+		{New(0, 4, 1), New(0, 1, 1)},
+		{New(0.25, 3, 0.5), New(0.15625, 0.5, 0.84375)},
+		{New(5, 5, 5), New(1, 1, 1)},
+	} {
+		if got := Smoothstep(low, high, tt.v); !got.IsEqualApprox(tt.want) {
+			t.Errorf("Smoothstep(%v, %v, %v) = %v, want %v", low, high, tt.v, got, tt.want)
+		}
+	}
+	if got, want := Smoothstep(New(1, 1, 1), New(1, 2, 1), New(1, 1.5, 0)), New(1, 0.5, 0); !got.IsEqualApprox(want) {
+		t.Errorf("Smoothstep with equal edges = %v, want %v", got, want)
+	}
+}
+
+func TestVector3_CatmullRom(t *testing.T) {
+	p0, p1, p2, p3 := New(-1, 2, 0), New(0, 0, 1), New(3, 1, 2), New(4, 4, -1)
+	for _, w := range []float64{0, 0.4, 1} {
+		// Tension 0.5 matches the existing CubicInterpolate.
+		if got, want := CatmullRom(p0, p1, p2, p3, w, 0.5), p1.CubicInterpolate(p2, p0, p3, w); !got.IsEqualApprox(want) {
+			t.Errorf("CatmullRom(%v) = %v, want %v", w, got, want)
+		}
+	}
+
+	// Evenly spaced points give the uniform curve.
+	e0, e1, e2, e3 := New(0, 0, 0), New(1, 2, 2), New(3, 3, 4), New(5, 5, 5)
+	for _, w := range []float64{0.25, 0.5, 0.75} {
+		if got, want := CatmullRomCentripetal(e0, e1, e2, e3, w), CatmullRom(e0, e1, e2, e3, w, 0.5); !got.IsEqualApprox(want) {
+			t.Errorf("CatmullRomCentripetal(even, %v) = %v, want %v", w, got, want)
+		}
+	}
+	// Uneven spacing changes the curve but not its end points.
+	p0 = New(-20, 0, 0)
+	if got := CatmullRomCentripetal(p0, p1, p2, p3, 0); !got.IsEqualApprox(p1) {
+		t.Errorf("CatmullRomCentripetal(0) = %v, want %v", got, p1)
+	}
+	if got := CatmullRomCentripetal(p0, p1, p2, p3, 1); !got.IsEqualApprox(p2) {
+		t.Errorf("CatmullRomCentripetal(1) = %v, want %v", got, p2)
+	}
+	if got, uniform := CatmullRomCentripetal(p0, p1, p2, p3, 0.5), CatmullRom(p0, p1, p2, p3, 0.5, 0.5); got.IsEqualApprox(uniform) {
+		t.Errorf("CatmullRomCentripetal(uneven, 0.5) = %v, the same as the uniform spline", got)
+	}
+}
+
+func TestVector3_IsEqualApproxTol(t *testing.T) {
+	a := New(1_250_000, -830_000, 42_000)
+	if !a.IsEqualApproxTol(New(1_250_000.4, -830_000.3, 41_999.9), 0.5) {
+		t.Errorf("IsEqualApproxTol within 0.5 = false, want true")
+	}
+	if a.IsEqualApproxTol(New(1_250_000.4, -830_000.3, 42_000.6), 0.5) {
+		t.Errorf("IsEqualApproxTol with Z off by 0.6 = true, want false")
+	}
+	if a.IsEqualApprox(New(1_250_000.4, -830_000.3, 41_999.9)) {
+		t.Errorf("IsEqualApprox with the default tolerance = true, want false")
+	}
+}
+
 func TestVector3_Inverse(t *testing.T) {}
 
 func TestVector3_Slide(t *testing.T) {}
@@ -87,3 +201,588 @@ func TestVector3_Reflect(t *testing.T) {}
 func TestVector3_Rotate(t *testing.T) {}
 
 func TestVector3_Rotated(t *testing.T) {}
+
+func TestVector3_AbsAll(t *testing.T) {
+	in := []Vector3{New(-1, 2, -3), New(0, -3.5, 0), New(4, 0, -0.25)}
+	want := []Vector3{New(1, 2, 3), New(0, 3.5, 0), New(4, 0, 0.25)}
+	got := AbsAll(in)
+	if len(got) != len(want) {
+		t.Fatalf("AbsAll() returned %d vectors, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("AbsAll()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if in[0] != New(-1, 2, -3) || in[2] != New(4, 0, -0.25) {
+		t.Errorf("AbsAll() modified its input: %v", in)
+	}
+}
+
+func TestVector3_SignAll(t *testing.T) {
+	in := []Vector3{New(-1, 2, -3), New(0, -3.5, 0), New(4, 0, -0.25)}
+	want := []Vector3{New(-1, 1, -1), New(0, -1, 0), New(1, 0, -1)}
+	got := SignAll(in)
+	if len(got) != len(want) {
+		t.Fatalf("SignAll() returned %d vectors, want %d", len(got), len(want))
+	}
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("SignAll()[%d] = %v, want %v", i, got[i], want[i])
+		}
+	}
+	if in[0] != New(-1, 2, -3) || in[1] != New(0, -3.5, 0) {
+		t.Errorf("SignAll() modified its input: %v", in)
+	}
+}
+
+func TestVector3_Centroid(t *testing.T) {
+	if got := Centroid(nil); got != Zero() {
+		t.Errorf("Centroid(nil) = %v, want zero", got)
+	}
+	if got, want := Centroid([]Vector3{New(0, 0, 0), New(2, 4, -6), New(4, 2, 0)}), New(2, 2, -2); !got.IsEqualApprox(want) {
+		t.Errorf("Centroid(triangle) = %v, want %v", got, want)
+	}
+
+	// A large cloud far from the origin: summing a million coordinates near
+	// 1e6 naively loses digits, the compensated sum does not.
+	p := New(1e6+0.1, -1e6-0.3, 0.7)
+	points := make([]Vector3, 1_000_000)
+	for i := range points {
+		points[i] = p
+	}
+	got := Centroid(points)
+	if math.Abs(got.X-p.X) > 1e-9 || math.Abs(got.Y-p.Y) > 1e-9 || math.Abs(got.Z-p.Z) > 1e-12 {
+		t.Errorf("Centroid(1e6 copies of %v) = %v", p, got)
+	}
+}
+
+func TestVector3_AssignMatchesValue(t *testing.T) {
+	a := New(1.25, -3.5, 0.5)
+	b := New(-0.75, 8.125, -2)
+
+	add := a
+	add.AddAssign(b)
+	if add != a.Add(b) {
+		t.Errorf("AddAssign() = %v, want %v", add, a.Add(b))
+	}
+
+	sub := a
+	sub.SubAssign(b)
+	if sub != a.Sub(b) {
+		t.Errorf("SubAssign() = %v, want %v", sub, a.Sub(b))
+	}
+
+	mul := a
+	mul.MulfAssign(0.3)
+	if mul != a.Mulf(0.3) {
+		t.Errorf("MulfAssign() = %v, want %v", mul, a.Mulf(0.3))
+	}
+
+	lerp := a
+	lerp.LerpAssign(b, 0.4)
+	if lerp != a.Lerp(b, 0.4) {
+		t.Errorf("LerpAssign() = %v, want %v", lerp, a.Lerp(b, 0.4))
+	}
+
+	norm := a
+	norm.Normalize()
+	if norm != a.Normalized() {
+		t.Errorf("Normalize() = %v, want %v", norm, a.Normalized())
+	}
+}
+
+func TestVector3_ValueMethodsDoNotMutate(t *testing.T) {
+	a := New(1.25, -3.5, 0.5)
+	b := New(-0.75, 8.125, -2)
+	a.Add(b)
+	a.Sub(b)
+	a.Mulf(0.3)
+	a.Lerp(b, 0.4)
+	a.Normalized()
+	if a != New(1.25, -3.5, 0.5) || b != New(-0.75, 8.125, -2) {
+		t.Errorf("value methods mutated their operands: a = %v, b = %v", a, b)
+	}
+}
+
+const benchParticles = 1024
+
+var benchSink Vector3
+
+func BenchmarkVector3_IntegrateValue(b *testing.B) {
+	pos := make([]Vector3, benchParticles)
+	vel := make([]Vector3, benchParticles)
+	for i := range vel {
+		vel[i] = New(1, 2, 3)
+	}
+	acc := New(0, -9.8, 0)
+	dt := 1.0 / 60.0
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range pos {
+			vel[i] = vel[i].Add(acc.Mulf(dt))
+			pos[i] = pos[i].Add(vel[i].Mulf(dt))
+		}
+	}
+	benchSink = pos[0]
+}
+
+func BenchmarkVector3_IntegrateInPlace(b *testing.B) {
+	pos := make([]Vector3, benchParticles)
+	vel := make([]Vector3, benchParticles)
+	for i := range vel {
+		vel[i] = New(1, 2, 3)
+	}
+	acc := New(0, -9.8, 0)
+	dt := 1.0 / 60.0
+	b.ResetTimer()
+	for n := 0; n < b.N; n++ {
+		for i := range pos {
+			step := acc
+			step.MulfAssign(dt)
+			vel[i].AddAssign(step)
+			step = vel[i]
+			step.MulfAssign(dt)
+			pos[i].AddAssign(step)
+		}
+	}
+	benchSink = pos[0]
+}
+
+func TestVector3_InvalidInput(t *testing.T) {
+	v := New(1, 1, 0)
+	tests := []struct {
+		name    string
+		checked func() (Vector3, error)
+		plain   func() Vector3
+		want    Vector3
+		wantErr error
+	}{
+		{"Reflect non-normalized", func() (Vector3, error) { return v.ReflectChecked(New(0, 2, 0)) }, func() Vector3 { return v.Reflect(New(0, 2, 0)) }, New(-1, 1, 0), nil},
+		{"Reflect zero", func() (Vector3, error) { return v.ReflectChecked(Zero()) }, func() Vector3 { return v.Reflect(Zero()) }, v, zerogdscript.ErrZeroLength},
+		{"Slide non-normalized", func() (Vector3, error) { return v.SlideChecked(New(0, 3, 0)) }, func() Vector3 { return v.Slide(New(0, 3, 0)) }, New(1, 0, 0), nil},
+		{"Slide zero", func() (Vector3, error) { return v.SlideChecked(Zero()) }, func() Vector3 { return v.Slide(Zero()) }, v, zerogdscript.ErrZeroLength},
+		{"Bounce non-normalized", func() (Vector3, error) { return v.BounceChecked(New(0, 2, 0)) }, func() Vector3 { return v.Bounce(New(0, 2, 0)) }, New(1, -1, 0), nil},
+		{"Bounce zero", func() (Vector3, error) { return v.BounceChecked(Zero()) }, func() Vector3 { return v.Bounce(Zero()) }, v, zerogdscript.ErrZeroLength},
+		{"Rotated non-normalized axis", func() (Vector3, error) { return New(1, 0, 0).Rotated(New(0, 0, 5), math.Pi/2), nil }, func() Vector3 { return New(1, 0, 0).Rotated(New(0, 0, 5), math.Pi/2) }, New(0, 1, 0), nil},
+		{"Rotated zero axis", func() (Vector3, error) { return v.Rotated(Zero(), 1), nil }, func() Vector3 { return v.Rotated(Zero(), 1) }, v, nil},
+	}
+	for _, tt := range tests {
+		got, err := tt.checked()
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("%s: error = %v, want %v", tt.name, err, tt.wantErr)
+		}
+		if !got.IsEqualApprox(tt.want) {
+			t.Errorf("%s: checked = %v, want %v", tt.name, got, tt.want)
+		}
+		if plain := tt.plain(); !plain.IsEqualApprox(tt.want) {
+			t.Errorf("%s: plain = %v, want %v", tt.name, plain, tt.want)
+		}
+	}
+
+	// Division by zero follows IEEE-754 instead of forcing +Inf.
+	if q := New(-1, 1, 0).Divf(0); !math.IsInf(q.X, -1) || !math.IsInf(q.Y, 1) || !math.IsNaN(q.Z) {
+		t.Errorf("Divf(0) = %v, want (-Inf, +Inf, NaN)", q)
+	}
+}
+
+func TestVector3_IsFinite(t *testing.T) {
+	if !New(1.5, -1e300, 0).IsFinite() {
+		t.Errorf("IsFinite() = false for a finite vector")
+	}
+	for _, bad := range []float64{math.Inf(1), math.Inf(-1), math.NaN()} {
+		for _, v := range []Vector3{New(bad, 0, 0), New(0, bad, 0), New(0, 0, bad)} {
+			if v.IsFinite() {
+				t.Errorf("%v.IsFinite() = true, want false", v)
+			}
+		}
+	}
+}
+
+func TestVector3_SafeNormalized(t *testing.T) {
+	up := New(0, 1, 0)
+	for _, v := range []Vector3{Zero(), New(1e-6, -1e-6, 0), New(0, 0, -5e-6)} {
+		if got := v.SafeNormalized(up); got != up {
+			t.Errorf("%v.SafeNormalized = %v, want the fallback %v", v, got, up)
+		}
+		if got, ok := v.TryNormalized(); ok || got != Zero() {
+			t.Errorf("%v.TryNormalized = %v, %v, want zero, false", v, got, ok)
+		}
+	}
+
+	for _, tt := range []struct {
+		in, want Vector3
+	}{
+		{New(3, 0, 4), New(0.6, 0, 0.8)},
+		{New(0, -2, 0), New(0, -1, 0)},
+		{New(1e-4, 0, 0), New(1, 0, 0)},
+	} {
+		if got := tt.in.SafeNormalized(up); !got.IsEqualApprox(tt.want) {
+			t.Errorf("%v.SafeNormalized = %v, want %v", tt.in, got, tt.want)
+		}
+		if got, ok := tt.in.TryNormalized(); !ok || !got.IsEqualApprox(tt.want) {
+			t.Errorf("%v.TryNormalized = %v, %v, want %v, true", tt.in, got, ok, tt.want)
+		}
+	}
+}
+
+func TestVector3_MinMax(t *testing.T) {
+	a, b := New(-1, 4, -2.5), New(3, -4, -2)
+	if got, want := a.Min(b), New(-1, -4, -2.5); got != want {
+		t.Errorf("Min = %v, want %v", got, want)
+	}
+	if got, want := a.Max(b), New(3, 4, -2); got != want {
+		t.Errorf("Max = %v, want %v", got, want)
+	}
+	if a.Min(b) != b.Min(a) || a.Max(b) != b.Max(a) {
+		t.Errorf("Min and Max should not depend on the operand order")
+	}
+}
+
+func TestVector3_AxisIndex(t *testing.T) {
+	for _, tt := range []struct {
+		v        Vector3
+		min, max int
+	}{
+		{New(1, 2, 3), 0, 2},
+		{New(3, 2, 1), 2, 0},
+		{New(2, -5, 7), 1, 2},
+		{New(-1, 8, 0), 0, 1},
+		// Ties: the first largest wins, while Godot's min favours the later axis.
+		{New(1, 1, 1), 2, 0},
+		{New(5, 5, 1), 2, 0},
+		{New(1, 5, 5), 0, 1},
+		{New(5, 1, 5), 1, 0},
+		{New(1, 1, 5), 1, 2},
+		{New(1, 5, 1), 2, 1},
+	} {
+		if got := tt.v.MinAxisIndex(); got != tt.min {
+			t.Errorf("%v.MinAxisIndex() = %d, want %d", tt.v, got, tt.min)
+		}
+		if got := tt.v.MaxAxisIndex(); got != tt.max {
+			t.Errorf("%v.MaxAxisIndex() = %d, want %d", tt.v, got, tt.max)
+		}
+	}
+}
+
+func TestVector3_Clamp(t *testing.T) {
+	min, max := New(-1, 0, 2), New(1, 5, 4)
+	for _, tt := range []struct {
+		in, want Vector3
+	}{
+		{New(-3, -1, 0), New(-1, 0, 2)},
+		{New(3, 9, 7), New(1, 5, 4)},
+		{New(0.5, 2.5, 3), New(0.5, 2.5, 3)},
+	} {
+		if got := tt.in.Clamp(min, max); got != tt.want {
+			t.Errorf("%v.Clamp = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+
+	for _, tt := range []struct {
+		in, want Vector3
+	}{
+		{New(-3, -2, -5), New(-1, -1, -1)},
+		{New(3, 2, 5), New(1, 1, 1)},
+		{New(0.5, -0.5, 0), New(0.5, -0.5, 0)},
+	} {
+		if got := tt.in.Clampf(-1, 1); got != tt.want {
+			t.Errorf("%v.Clampf = %v, want %v", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestVector3_Snapped(t *testing.T) {
+	for _, tt := range []struct {
+		in   Vector3
+		step float64
+		want Vector3
+	}{
+		{New(1.2, 2.7, -0.3), 1, New(1, 3, 0)},
+		{New(-1.2, -2.7, 3.49), 1, New(-1, -3, 3)},
+		{New(0.1, 0.2, 0.4), 0.25, New(0, 0.25, 0.5)},
+		{New(-0.1, -0.2, -0.4), 0.25, New(0, -0.25, -0.5)},
+		{New(10.6, -3.9, 7.74), 0.25, New(10.5, -4, 7.75)},
+	} {
+		if got := tt.in.Snappedf(tt.step); !got.IsEqualApprox(tt.want) {
+			t.Errorf("%v.Snappedf(%v) = %v, want %v", tt.in, tt.step, got, tt.want)
+		}
+		if got := tt.in.Snapped(New(tt.step, tt.step, tt.step)); !got.IsEqualApprox(tt.want) {
+			t.Errorf("%v.Snapped(%v) = %v, want %v", tt.in, tt.step, got, tt.want)
+		}
+	}
+
+	// Each axis snaps to its own step.
+	if got, want := New(1.3, 1.3, -1.3).Snapped(New(1, 0.25, 0.5)), New(1, 1.25, -1.5); !got.IsEqualApprox(want) {
+		t.Errorf("per-axis Snapped = %v, want %v", got, want)
+	}
+}
+
+func TestVector3_SnappedWithOffset(t *testing.T) {
+	step := New(0.5, 0.5, 0.5)
+	offset := New(0.25, 0.25, 0.25)
+	for _, tt := range []struct {
+		in, want float64
+	}{
+		{0.25, 0.25}, {0.4, 0.25}, {0.49, 0.25}, {0.5, 0.75}, {0.6, 0.75}, {0.75, 0.75},
+		{0.01, 0.25}, {-0.1, -0.25}, {-0.49, -0.25}, {-0.51, -0.75}, {10.1, 10.25},
+	} {
+		got := New(tt.in, tt.in, tt.in).SnappedWithOffset(step, offset)
+		if want := New(tt.want, tt.want, tt.want); !got.IsEqualApprox(want) {
+			t.Errorf("%v snapped = %v, want %v", tt.in, got, want)
+		}
+	}
+
+	// Each axis has its own step and offset, and a zero step leaves the axis alone.
+	got := New(1.3, 1.3, 1.3).SnappedWithOffset(New(1, 0.5, 0), New(0, 0.1, 7))
+	if want := New(1, 1.1, 1.3); !got.IsEqualApprox(want) {
+		t.Errorf("per-axis snap = %v, want %v", got, want)
+	}
+}
+
+func TestVector3_SmoothDamp(t *testing.T) {
+	const dt = 1.0 / 60
+	pos, vel := New(0, 5, -2), Zero()
+	target := New(3, -1, 4)
+	dist := pos.DistanceTo(target)
+	for i := 0; i < 300; i++ {
+		pos = pos.SmoothDamp(target, &vel, 0.3, math.Inf(1), dt)
+		d := pos.DistanceTo(target)
+		if d > dist {
+			t.Fatalf("frame %d: moved away from the target, %v > %v", i, d, dist)
+		}
+		dist = d
+	}
+	if pos != target || vel != Zero() {
+		t.Errorf("ended at %v moving %v, want exactly %v at rest", pos, vel, target)
+	}
+
+	// maxSpeed caps the length of the velocity, not each axis.
+	pos, vel = New(0, 5, -2), Zero()
+	for i := 0; i < 60; i++ {
+		prev := pos
+		pos = pos.SmoothDamp(target, &vel, 0.3, 2, dt)
+		if speed := pos.DistanceTo(prev) / dt; speed > 2+1e-9 || vel.Length() > 2+1e-9 {
+			t.Fatalf("frame %d: moving at %v (velocity %v), want at most 2", i, speed, vel)
+		}
+	}
+
+	pos, vel = New(0, 5, -2), Zero()
+	if pos = pos.SmoothDamp(target, &vel, 0, math.Inf(1), dt); pos != target || vel != Zero() {
+		t.Errorf("zero smoothTime gave %v moving %v, want %v at rest", pos, vel, target)
+	}
+}
+
+func TestVector3_Spring(t *testing.T) {
+	pos, vel := New(0, 5, -2), Zero()
+	target := New(3, -1, 4)
+	for i := 0; i < 600; i++ {
+		pos, vel = pos.Spring(target, vel, 100, 20, 1.0/120)
+	}
+	if !pos.IsEqualApprox(target) {
+		t.Errorf("spring settled at %v, want %v", pos, target)
+	}
+}
+
+func TestVector3_Orthogonal(t *testing.T) {
+	inputs := []Vector3{
+		New(1, 0, 0), New(0, 1, 0), New(0, 0, 1), New(0, 0, -1),
+		New(1, 1, 1), New(-3, 0.5, 2), New(1e-3, 1e3, -1), New(1e6, -2e6, 3),
+		New(0.2, 0.2, 0), New(1e-4, 0, 0),
+	}
+	r := rng.New(7)
+	for i := 0; i < 50; i++ {
+		inputs = append(inputs, New(r.Randd()*2-1, r.Randd()*2-1, r.Randd()*2-1).Mulf(r.Randd()*100))
+	}
+	for _, v := range inputs {
+		o := v.Orthogonal()
+		if !zerogdscript.IsEqualApprox(o.Length(), 1) {
+			t.Errorf("Orthogonal(%v) = %v, want unit length", v, o)
+		}
+		if d := o.Dot(v.Normalized()); !zerogdscript.IsZeroApprox(d) {
+			t.Errorf("Orthogonal(%v) = %v, dot with the input %v", v, o, d)
+		}
+	}
+	for _, v := range []Vector3{Zero(), New(1e-7, 0, 0), New(-1e-6, 2e-6, 0)} {
+		if got := v.Orthogonal(); got != Zero() {
+			t.Errorf("Orthogonal(%v) = %v, want zero", v, got)
+		}
+	}
+}
+
+func TestVector3_TangentBasis(t *testing.T) {
+	dirs := []Vector3{
+		New(1, 0, 0), New(-1, 0, 0), New(0, 1, 0), New(0, -1, 0), New(0, 0, 1), New(0, 0, -1),
+		New(1, 2, 3), New(-4, 0.5, -2), New(0, 0, 7),
+		// Near the -Z pole, where Frisvad's original formula breaks down.
+		New(1e-4, 0, -1), New(0, 1e-7, -1), New(1e-9, -1e-9, -1), New(-1e-12, 1e-12, -1),
+		New(1e-9, 1e-9, 1),
+	}
+	// A deterministic spread over the sphere.
+	for i := 0; i < 200; i++ {
+		z := 1 - 2*(float64(i)+0.5)/200
+		phi := float64(i) * math.Pi * (3 - math.Sqrt(5))
+		r := math.Sqrt(1 - z*z)
+		dirs = append(dirs, New(r*math.Cos(phi), r*math.Sin(phi), z))
+	}
+	for _, d := range dirs {
+		tangent, bitangent := d.TangentBasis()
+		n := d.Normalized()
+		if !tangent.IsNormalized() || !bitangent.IsNormalized() {
+			t.Errorf("%v: tangent %v and bitangent %v are not unit length", d, tangent, bitangent)
+		}
+		if !zerogdscript.IsZeroApprox(tangent.Dot(n)) || !zerogdscript.IsZeroApprox(bitangent.Dot(n)) || !zerogdscript.IsZeroApprox(tangent.Dot(bitangent)) {
+			t.Errorf("%v: tangent %v and bitangent %v are not orthogonal", d, tangent, bitangent)
+		}
+		if !tangent.Cross(bitangent).IsEqualApprox(n) {
+			t.Errorf("%v: tangent x bitangent = %v, want %v", d, tangent.Cross(bitangent), n)
+		}
+	}
+	if tangent, bitangent := Zero().TangentBasis(); tangent != New(1, 0, 0) || bitangent != New(0, 1, 0) {
+		t.Errorf("Zero().TangentBasis() = %v, %v, want the X and Y axes", tangent, bitangent)
+	}
+}
+
+func TestVector3_IsUp(t *testing.T) {
+	up := New(0, 1, 0)
+	for _, tt := range []struct {
+		name   string
+		v      Vector3
+		isUp   bool
+		isDown bool
+	}{
+		{"up", New(0, 1, 0), true, false},
+		{"long and tilted up", New(3, 5, -2), true, false},
+		{"barely up", New(1, 1e-9, 0), true, false},
+		{"down", New(0, -1, 0), false, true},
+		{"tilted down", New(-2, -0.5, 4), false, true},
+		{"sideways x", New(1, 0, 0), false, false},
+		{"sideways z", New(0, 0, -7), false, false},
+		{"zero", Zero(), false, false},
+	} {
+		if got := tt.v.IsUp(up); got != tt.isUp {
+			t.Errorf("%s: %v.IsUp(%v) = %v, want %v", tt.name, tt.v, up, got, tt.isUp)
+		}
+		if got := tt.v.IsDown(up); got != tt.isDown {
+			t.Errorf("%s: %v.IsDown(%v) = %v, want %v", tt.name, tt.v, up, got, tt.isDown)
+		}
+	}
+
+	// The up vector does not need to be unit length.
+	if !New(0, 0.2, 0).IsUp(New(0, 10, 0)) || !New(0, -0.2, 0).IsDown(New(0, 10, 0)) {
+		t.Errorf("IsUp/IsDown depend on the length of up")
+	}
+}
+
+func TestVector3_IsWithinCone(t *testing.T) {
+	forward := New(0, 0, -1)
+	tests := []struct {
+		name      string
+		direction Vector3
+		halfAngle float64
+		want      bool
+	}{
+		{"straight ahead", New(0, 0, -5), math.Pi / 6, true},
+		{"inside", New(0.1, 0.1, -1), math.Pi / 6, true},
+		{"on the boundary", New(0.5, 0, -math.Sqrt(3)/2), math.Pi / 6, true},
+		{"just outside", New(0.501, 0, -math.Sqrt(3)/2), math.Pi / 6, false},
+		{"behind", New(0, 0, 1), math.Pi / 6, false},
+		{"sideways in wide cone", New(1, 1, 0), math.Pi / 2, true},
+		{"zero direction", Zero(), math.Pi / 6, false},
+	}
+	for _, tt := range tests {
+		if got := tt.direction.IsWithinCone(forward, tt.halfAngle); got != tt.want {
+			t.Errorf("%s: %v.IsWithinCone(%v, %v) = %v, want %v", tt.name, tt.direction, forward, tt.halfAngle, got, tt.want)
+		}
+	}
+	if New(1, 0, 0).IsWithinCone(Zero(), math.Pi) {
+		t.Errorf("zero forward should never contain a direction")
+	}
+}
+
+func TestVector3_Validate(t *testing.T) {
+	if err := New(1, -2, 3).Validate(); err != nil {
+		t.Errorf("Validate() = %v, want nil", err)
+	}
+	for _, tt := range []struct {
+		v    Vector3
+		want string
+	}{
+		{New(math.NaN(), 0, 0), "Vector3.X: value is not finite: NaN"},
+		{New(0, math.Inf(1), 0), "Vector3.Y: value is not finite: +Inf"},
+		{New(0, 0, math.NaN()), "Vector3.Z: value is not finite: NaN"},
+	} {
+		err := tt.v.Validate()
+		if err == nil || err.Error() != tt.want || !errors.Is(err, zerogdscript.ErrNonFinite) {
+			t.Errorf("%v.Validate() = %v, want %q", tt.v, err, tt.want)
+		}
+	}
+}
+
+func TestVector3_SlerpDebug(t *testing.T) {
+	var reports []error
+	zerogdscript.SetDebug(true, func(err error) { reports = append(reports, err) })
+	defer zerogdscript.SetDebug(false, nil)
+
+	New(1, 0, 0).Slerp(New(0, math.NaN(), 0), 0.5)
+	// The rotation basis Slerp builds internally may report the NaN again.
+	want := "Vector3.Slerp: Vector3.Y: value is not finite: NaN"
+	if len(reports) == 0 || reports[0].Error() != want {
+		t.Errorf("reports = %v, want %q first", reports, want)
+	}
+}
+
+func TestVector3_AsArray(t *testing.T) {
+	v := New(1, 2, 3)
+	if got := v.AsArray(); got != [3]float64{1, 2, 3} {
+		t.Errorf("AsArray() = %v, want [1 2 3]", got)
+	}
+	if got := FromArray(v.AsArray()); got != v {
+		t.Errorf("FromArray(AsArray()) = %v, want %v", got, v)
+	}
+}
+
+func TestVector3_SliceToFloats(t *testing.T) {
+	src := []Vector3{New(1, 2, 3), New(4, 5, 6), New(7, 8, 9)}
+
+	floats := Vector3SliceToFloats(nil, src)
+	for i, v := range src {
+		a := v.AsArray()
+		if got := [3]float64{floats[3*i], floats[3*i+1], floats[3*i+2]}; got != a {
+			t.Errorf("element %d = %v, want %v", i, got, a)
+		}
+	}
+
+	// A buffer with capacity is reused, not reallocated.
+	buf := make([]float64, 0, 16)
+	if got := Vector3SliceToFloats(buf, src); len(got) != 9 || &got[0] != &buf[:1][0] {
+		t.Errorf("Vector3SliceToFloats did not reuse dst")
+	}
+
+	back := FloatsToVector3Slice(nil, append(floats, 10, 11))
+	if len(back) != len(src) {
+		t.Fatalf("FloatsToVector3Slice() returned %d vectors, want %d", len(back), len(src))
+	}
+	for i := range src {
+		if back[i] != FromArray([3]float64{floats[3*i], floats[3*i+1], floats[3*i+2]}) || back[i] != src[i] {
+			t.Errorf("element %d = %v, want %v", i, back[i], src[i])
+		}
+	}
+
+	// Converting back into the slice the floats came from is safe: each
+	// vector is read only from the float copy.
+	vs := append([]Vector3{}, src...)
+	vs = FloatsToVector3Slice(vs, Vector3SliceToFloats(nil, vs))
+	for i := range src {
+		if vs[i] != src[i] {
+			t.Errorf("in-place round trip element %d = %v, want %v", i, vs[i], src[i])
+		}
+	}
+
+	// Reusing one float buffer for two conversions leaves the second result only.
+	buf = Vector3SliceToFloats(buf, src)
+	buf = Vector3SliceToFloats(buf, src[:1])
+	if len(buf) != 3 || buf[0] != 1 || buf[2] != 3 {
+		t.Errorf("reused buffer = %v, want [1 2 3]", buf)
+	}
+}
@@ -0,0 +1,135 @@
+package zerogdscript
+
+import (
+	"math"
+	"sort"
+)
+
+// rootEpsilon is the relative tolerance, a few units in the last place, under
+// which a quadratic discriminant is treated as zero and two roots as the same
+// root. The cubic discriminant is built from the rounded coefficients of the
+// depressed cubic and needs the looser cubicEpsilon.
+const (
+	rootEpsilon  = 0x1p-50
+	cubicEpsilon = 1e-12
+)
+
+// SolveQuadratic returns the real roots of a·x² + b·x + c = 0 in ascending
+// order. A double root is returned once, and a discriminant that is zero up
+// to rounding counts as a double root rather than two nearly equal roots or
+// none. If a is zero the equation is solved as linear; if a and b are both
+// zero there is no single root to report and the result is empty, even when
+// c is also zero. The roots are computed with the cancellation-free form of
+// the quadratic formula, so a tiny a or c does not lose the small root.
+func SolveQuadratic(a, b, c float64) []float64 {
+	if a == 0 {
+		if b == 0 {
+			return nil
+		}
+		return []float64{-c / b}
+	}
+	disc := b*b - 4*a*c
+	if math.Abs(disc) <= rootEpsilon*(b*b+math.Abs(4*a*c)) {
+		return []float64{-b / (2 * a)}
+	}
+	if disc < 0 {
+		return nil
+	}
+	q := -0.5 * (b + math.Copysign(math.Sqrt(disc), b))
+	x0, x1 := q/a, c/q
+	if x0 > x1 {
+		x0, x1 = x1, x0
+	}
+	return []float64{x0, x1}
+}
+
+// SolveCubic returns the real roots of a·x³ + b·x² + c·x + d = 0 in ascending
+// order, each repeated root once. A discriminant that is zero up to rounding
+// gives a double or triple root, so distinct roots closer together than
+// about a millionth of their scale may be reported as one. If a is zero it solves the quadratic
+// b·x² + c·x + d = 0 with SolveQuadratic. The roots come from Cardano's
+// formula or, with three real roots, the trigonometric method, and are then
+// polished with Newton's method on the original polynomial.
+func SolveCubic(a, b, c, d float64) []float64 {
+	if a == 0 {
+		return SolveQuadratic(b, c, d)
+	}
+	if d == 0 {
+		// x = 0 is a root; the others solve the remaining quadratic exactly.
+		return uniqueRoots(append(SolveQuadratic(a, b, c), 0))
+	}
+
+	// Substitute x = t - B/3 to get the depressed cubic t³ + p·t + q = 0.
+	B, C, D := b/a, c/a, d/a
+	shift := B / 3
+	p := C - B*shift
+	q := 2*shift*shift*shift - shift*C + D
+
+	halfQ, thirdP := q/2, p/3
+	disc := halfQ*halfQ + thirdP*thirdP*thirdP
+	var ts []float64
+	switch {
+	case math.Abs(disc) <= cubicEpsilon*(halfQ*halfQ+math.Abs(thirdP*thirdP*thirdP)):
+		if thirdP == 0 {
+			ts = []float64{0}
+		} else {
+			// A single root and a double root.
+			ts = []float64{3 * q / p, -3 * q / (2 * p)}
+		}
+	case disc > 0:
+		// One real root. Add the terms of equal sign to avoid cancellation.
+		u := math.Cbrt(-halfQ - math.Copysign(math.Sqrt(disc), halfQ))
+		t := u
+		if u != 0 {
+			t -= thirdP / u
+		}
+		ts = []float64{t}
+	default:
+		// Three real roots: p < 0 here.
+		r := 2 * math.Sqrt(-thirdP)
+		phi := math.Acos(Clampf(3*q/(p*r), -1, 1)) / 3
+		ts = []float64{r * math.Cos(phi), r * math.Cos(phi-2*math.Pi/3), r * math.Cos(phi-4*math.Pi/3)}
+	}
+
+	roots := make([]float64, len(ts))
+	for i, t := range ts {
+		roots[i] = polishCubicRoot(a, b, c, d, t-shift)
+	}
+	return uniqueRoots(roots)
+}
+
+// polishCubicRoot refines x with a few Newton steps, keeping a step only if
+// it reduces the residual.
+func polishCubicRoot(a, b, c, d, x float64) float64 {
+	f := ((a*x+b)*x+c)*x + d
+	for i := 0; i < 4 && f != 0; i++ {
+		df := (3*a*x+2*b)*x + c
+		if df == 0 {
+			break
+		}
+		next := x - f/df
+		nf := ((a*next+b)*next+c)*next + d
+		if math.Abs(nf) >= math.Abs(f) {
+			break
+		}
+		x, f = next, nf
+	}
+	return x
+}
+
+// uniqueRoots sorts roots and drops those equal to their predecessor within
+// rootEpsilon relative to their magnitude.
+func uniqueRoots(roots []float64) []float64 {
+	sort.Float64s(roots)
+	res := roots[:0]
+	for _, x := range roots {
+		if len(res) > 0 {
+			prev := res[len(res)-1]
+			if x-prev <= rootEpsilon*math.Max(1, math.Max(math.Abs(x), math.Abs(prev))) {
+				continue
+			}
+		}
+		res = append(res, x)
+	}
+	return res
+}
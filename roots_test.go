@@ -0,0 +1,92 @@
+package zerogdscript
+
+import (
+	"math"
+	"testing"
+)
+
+// rootsMatch reports whether got holds exactly the roots in want, each to
+// within a relative tolerance.
+func rootsMatch(got, want []float64, tol float64) bool {
+	if len(got) != len(want) {
+		return false
+	}
+	for i := range got {
+		if math.IsNaN(got[i]) || math.Abs(got[i]-want[i]) > tol*math.Max(1, math.Abs(want[i])) {
+			return false
+		}
+	}
+	return true
+}
+
+func TestMathgd_SolveQuadratic(t *testing.T) {
+	tests := []struct {
+		name    string
+		a, b, c float64
+		want    []float64
+	}{
+		{"two roots", 1, -3, 2, []float64{1, 2}},
+		{"scaled", -2, 6, -4, []float64{1, 2}},
+		{"double root", 1, -2, 1, []float64{1}},
+		{"inexact double root", 1, -0.2, 0.01, []float64{0.1}},
+		{"no real roots", 1, 0, 1, nil},
+		{"symmetric", 1, 0, -4, []float64{-2, 2}},
+		{"zero root", 3, 6, 0, []float64{-2, 0}},
+		{"linear", 0, 2, -4, []float64{2}},
+		{"constant", 0, 0, 5, nil},
+		{"zero polynomial", 0, 0, 0, nil},
+		// (x - 1e-8)(x - 1e8): the naive formula loses the small root.
+		{"wide roots", 1, -(1e8 + 1e-8), 1, []float64{1e-8, 1e8}},
+		// 1e8 (x - 1e-4)(x + 3e-4)
+		{"large a", 1e8, 2e4, -3, []float64{-3e-4, 1e-4}},
+		// 1e-8 (x - 2)(x + 5e7)
+		{"small a", 1e-8, 0.5 - 2e-8, -1, []float64{-5e7, 2}},
+	}
+	for _, tt := range tests {
+		if got := SolveQuadratic(tt.a, tt.b, tt.c); !rootsMatch(got, tt.want, 1e-9) {
+			t.Errorf("%s: SolveQuadratic(%v, %v, %v) = %v, want %v", tt.name, tt.a, tt.b, tt.c, got, tt.want)
+		}
+	}
+}
+
+func TestMathgd_SolveCubic(t *testing.T) {
+	tests := []struct {
+		name       string
+		a, b, c, d float64
+		want       []float64
+	}{
+		{"three roots", 1, -6, 11, -6, []float64{1, 2, 3}},
+		{"double root", 1, -4, 5, -2, []float64{1, 2}},
+		{"triple root", 1, -6, 12, -8, []float64{2}},
+		{"one real root", 1, 0, 1, 1, []float64{-0.6823278038280193}},
+		{"zero root", 1, 0, -1, 0, []float64{-1, 0, 1}},
+		{"zero double root", 1, -1, 0, 0, []float64{0, 1}},
+		{"pure cube", 2, 0, 0, -16, []float64{2}},
+		{"quadratic", 0, 1, -3, 2, []float64{1, 2}},
+		{"linear", 0, 0, 2, -4, []float64{2}},
+		{"constant", 0, 0, 0, 1, nil},
+		// (x - 0.1)^2 (x - 2) with inexact coefficients.
+		{"inexact double root", 1, -2.2, 0.41, -0.02, []float64{0.1, 2}},
+	}
+	for _, tt := range tests {
+		if got := SolveCubic(tt.a, tt.b, tt.c, tt.d); !rootsMatch(got, tt.want, 1e-7) {
+			t.Errorf("%s: SolveCubic(%v, %v, %v, %v) = %v, want %v", tt.name, tt.a, tt.b, tt.c, tt.d, got, tt.want)
+		}
+	}
+
+	// Roots and leading coefficients spanning many orders of magnitude.
+	for _, a := range []float64{1e-8, 1, 1e8} {
+		for _, r := range [][3]float64{
+			{-1e4, 5e-3, 2},
+			{-3, 1e-4, 1e4},
+			{1e-2, 7, 1e3},
+		} {
+			b := -a * (r[0] + r[1] + r[2])
+			c := a * (r[0]*r[1] + r[0]*r[2] + r[1]*r[2])
+			d := -a * r[0] * r[1] * r[2]
+			if got := SolveCubic(a, b, c, d); !rootsMatch(got, r[:], 1e-9) {
+				t.Errorf("SolveCubic with a=%v and roots %v = %v", a, r, got)
+			}
+		}
+	}
+}
@@ -0,0 +1,79 @@
+package zerogdscript
+
+import (
+	"fmt"
+	"log"
+	"math"
+)
+
+// ValidationError is returned by the Validate methods. It names the type and
+// field that failed, and wraps ErrNonFinite, ErrNotNormalized, ErrZeroLength
+// or ErrSingular.
+type ValidationError struct {
+	Type  string
+	Field string
+	Err   error
+}
+
+func (e *ValidationError) Error() string {
+	if e.Field == "" {
+		return e.Type + ": " + e.Err.Error()
+	}
+	return e.Type + "." + e.Field + ": " + e.Err.Error()
+}
+
+func (e *ValidationError) Unwrap() error {
+	return e.Err
+}
+
+// ValidateFinite returns a ValidationError for typ.field if x is NaN or ±Inf.
+func ValidateFinite(typ, field string, x float64) error {
+	if math.IsNaN(x) || math.IsInf(x, 0) {
+		return &ValidationError{Type: typ, Field: field, Err: fmt.Errorf("%w: %v", ErrNonFinite, x)}
+	}
+	return nil
+}
+
+// Validator is implemented by the types that have a Validate method.
+type Validator interface {
+	Validate() error
+}
+
+var (
+	debug       bool
+	debugReport = logReport
+)
+
+func logReport(err error) {
+	log.Print(err)
+}
+
+// SetDebug turns debug mode on or off. In debug mode Xform, Slerp and Invert
+// validate their inputs and pass the first failure to report, prefixed with
+// the operation name. A nil report logs it with the standard log package.
+// Operations built on other checked operations may report a failure twice.
+//
+// SetDebug is not safe to call concurrently with the math operations; set it
+// once at startup.
+func SetDebug(enabled bool, report func(error)) {
+	if report == nil {
+		report = logReport
+	}
+	debug, debugReport = enabled, report
+}
+
+// IsDebug reports whether debug mode is on.
+func IsDebug() bool {
+	return debug
+}
+
+// DebugValidate validates values in order and reports the first failure for
+// op. Callers check IsDebug first so the non-debug path costs one branch.
+func DebugValidate(op string, values ...Validator) {
+	for _, v := range values {
+		if err := v.Validate(); err != nil {
+			debugReport(fmt.Errorf("%s: %w", op, err))
+			return
+		}
+	}
+}
@@ -0,0 +1,48 @@
+package zerogdscript
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+type fakeValue struct{ err error }
+
+func (f fakeValue) Validate() error { return f.err }
+
+func TestValidationError_Error(t *testing.T) {
+	err := ValidateFinite("Vector3", "Y", math.NaN())
+	if got, want := err.Error(), "Vector3.Y: value is not finite: NaN"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+	if !errors.Is(err, ErrNonFinite) {
+		t.Errorf("errors.Is(%v, ErrNonFinite) = false", err)
+	}
+	if err := ValidateFinite("Vector3", "Y", 1); err != nil {
+		t.Errorf("ValidateFinite(1) = %v, want nil", err)
+	}
+
+	noField := &ValidationError{Type: "Quaternion", Err: ErrNotNormalized}
+	if got, want := noField.Error(), "Quaternion: quaternion is not normalized"; got != want {
+		t.Errorf("Error() = %q, want %q", got, want)
+	}
+}
+
+func TestSetDebug(t *testing.T) {
+	var reports []error
+	SetDebug(true, func(err error) { reports = append(reports, err) })
+	defer SetDebug(false, nil)
+
+	if !IsDebug() {
+		t.Fatalf("IsDebug() = false after SetDebug(true)")
+	}
+	first := errors.New("first")
+	DebugValidate("Op", fakeValue{}, fakeValue{first}, fakeValue{errors.New("second")})
+	DebugValidate("Op", fakeValue{})
+	if len(reports) != 1 {
+		t.Fatalf("got %d reports, want 1", len(reports))
+	}
+	if got, want := reports[0].Error(), "Op: first"; got != want || !errors.Is(reports[0], first) {
+		t.Errorf("report = %q, want %q wrapping the failure", got, want)
+	}
+}